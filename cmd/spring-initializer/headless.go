@@ -0,0 +1,145 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/eslam-allam/spring-initializer-go/service/config"
+	"github.com/eslam-allam/spring-initializer-go/service/files"
+	"github.com/eslam-allam/spring-initializer-go/service/hooks"
+	"github.com/eslam-allam/spring-initializer-go/service/importer"
+	"github.com/eslam-allam/spring-initializer-go/service/profile"
+	"github.com/eslam-allam/spring-initializer-go/service/springio"
+	"github.com/eslam-allam/spring-initializer-go/service/templates"
+)
+
+// headlessConfig combines the profile, build file and share url given on the
+// command line, each overriding the previous one. The template directory of
+// the profile is returned along with it.
+func headlessConfig(meta springio.SpringInitMeta) (springio.ProjectConfig, string, error) {
+	var config springio.ProjectConfig
+	if *profileName == "" && *importPath == "" && *shareUrl == "" {
+		return config, "", errors.New("headless mode requires a profile, a build file or a share url")
+	}
+
+	var profileTemplates string
+	if *profileName != "" {
+		p, err := profile.Load(*profileName)
+		if err != nil {
+			return config, "", err
+		}
+		config = p.ProjectConfig
+		profileTemplates = p.Templates
+	}
+	if *importPath != "" {
+		target, err := files.ExpandPath(*importPath)
+		if err != nil {
+			return config, "", err
+		}
+		result, err := importer.Import(target, meta.DependencyIds())
+		if err != nil {
+			return config, "", err
+		}
+		if len(result.Unmapped) > 0 {
+			fmt.Fprintf(os.Stderr, "Skipping unmapped artifacts: %s\n", strings.Join(result.Unmapped, ", "))
+		}
+		config = config.Override(result.Config)
+	}
+	if *shareUrl != "" {
+		shared, err := springio.ParseShareUrl(*shareUrl)
+		if err != nil {
+			return config, "", err
+		}
+		config = config.Override(shared)
+	}
+	return config, profileTemplates, nil
+}
+
+func runHeadless(targetDirectory string, settings config.Settings) error {
+	conflict, err := templates.ParseConflict(settings.TemplateConflicts)
+	if err != nil {
+		return err
+	}
+	meta, err := springio.GetMeta()
+	if err != nil {
+		return fmt.Errorf("failed to load metadata: %v", err)
+	}
+
+	config, profileTemplates, err := headlessConfig(meta)
+	if err != nil {
+		return err
+	}
+	dirs, err := templates.Dirs(settings.Templates, profileTemplates)
+	if err != nil {
+		return err
+	}
+
+	if unavailable := meta.Unavailable(config); len(unavailable) > 0 {
+		return fmt.Errorf("values not available in the current metadata: %s", strings.Join(unavailable, ", "))
+	}
+
+	config = meta.WithDefaults(config)
+	action, ok := meta.Action(config.Type)
+	if !ok {
+		return fmt.Errorf("unknown project type: %s", config.Type)
+	}
+
+	fullPath, isZip, err := springio.GenerateProject(action, config, targetDirectory)
+	if err != nil {
+		return err
+	}
+	if isZip {
+		root, err := files.ZipRoot(fullPath)
+		if err != nil {
+			return fmt.Errorf("failed to read zip file: %v", err)
+		}
+		if err := files.UnzipFile(fullPath, targetDirectory); err != nil {
+			return fmt.Errorf("failed to extract project: %v", err)
+		}
+		if err := os.Remove(fullPath); err != nil {
+			return fmt.Errorf("project extracted but could not delete zip: %v", err)
+		}
+		switch {
+		case root != "":
+			projectDir := filepath.Join(targetDirectory, root)
+			if len(dirs) > 0 {
+				result, err := templates.Apply(projectDir, config, conflict, dirs...)
+				if err != nil {
+					return fmt.Errorf("project extracted but templates failed: %v", err)
+				}
+				fmt.Println(result)
+			}
+			if err := runHeadlessHooks(settings.Hooks, projectDir); err != nil {
+				return err
+			}
+		case len(dirs) > 0 || len(settings.Hooks) > 0:
+			// Templates and hooks would land in the target directory.
+			fmt.Fprintln(os.Stderr, "warning: the project isn't in a single folder, so its templates and hooks were skipped")
+		}
+	}
+	fmt.Printf("Project generated in %s\n", targetDirectory)
+	return nil
+}
+
+// runHeadlessHooks runs the post-generation hooks, printing their output as
+// it comes.
+func runHeadlessHooks(hs []hooks.Hook, projectDir string) error {
+	failed := 0
+	for event := range hooks.Run(hs, projectDir) {
+		title := hs[event.Step].Title()
+		switch {
+		case event.Done && event.Err != nil:
+			failed++
+			fmt.Fprintf(os.Stderr, "[%s] failed: %v\n", title, event.Err)
+		case !event.Done:
+			fmt.Printf("[%s] %s\n", title, event.Line)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d hooks failed", failed, len(hs))
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 	"os"
@@ -8,15 +9,81 @@ import (
 
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/eslam-allam/spring-initializer-go/constants"
+	"github.com/eslam-allam/spring-initializer-go/models/keymap"
 	"github.com/eslam-allam/spring-initializer-go/models/mainModel"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
+	"github.com/eslam-allam/spring-initializer-go/service/config"
 	"github.com/eslam-allam/spring-initializer-go/service/files"
+	"github.com/eslam-allam/spring-initializer-go/service/hooks"
+	"github.com/eslam-allam/spring-initializer-go/service/templates"
 	"github.com/eslam-allam/spring-initializer-go/service/term"
+	"github.com/muesli/termenv"
 )
 
 var logger *log.Logger = log.Default()
 
+var (
+	profileName = flag.String("profile", "", "name of a saved profile to apply")
+	shareUrl    = flag.String("url", "", "start.spring.io share link to import")
+	importPath  = flag.String("import", "", "existing pom.xml, build.gradle or project directory to import")
+	upgradeDir  = flag.String("upgrade", "", "existing project directory to compare against a freshly generated one")
+	bootVersion = flag.String("boot", "", "boot version used with --upgrade (defaults to the recommended one)")
+	headless    = flag.Bool("headless", false, "generate and extract the project without starting the UI")
+	initGit     = flag.Bool("git", false, "make extracted projects git repositories once the other hooks ran")
+	wizard      = flag.Bool("wizard", false, "walk through the options one step at a time (default from config.yaml)")
+	themeName   = flag.String("theme", "", "colour theme: dark, light, high-contrast, solarized, monochrome or a custom theme (default from config.yaml)")
+	noColor     = flag.Bool("no-color", false, "don't use any colours, same as setting NO_COLOR")
+)
+
+// resolveTheme picks the theme chosen with --theme or in the settings. The
+// terminal is only recoloured for a chosen theme, otherwise the dark or light
+// theme is matched to the terminal's own background.
+func resolveTheme(settings config.Settings) (t theme.Theme, recolour bool) {
+	if *noColor || termenv.EnvNoColor() {
+		// NO_COLOR makes lipgloss drop bold and underline along with the
+		// colours, which the monochrome theme relies on.
+		lipgloss.SetColorProfile(termenv.ANSI)
+		return theme.Monochrome, false
+	}
+	name := *themeName
+	if name == "" {
+		name = settings.Theme
+	}
+	if name == "" {
+		return theme.Detect(), false
+	}
+	t, err := theme.Load(name)
+	if err != nil {
+		logger.Printf("Error loading theme: %v", err)
+		return theme.Detect(), false
+	}
+	return t, !t.IsMonochrome()
+}
+
+// applyKeys rebinds the keys configured in the settings, returning the
+// invalid bindings and conflicts it finds.
+func applyKeys(settings config.Settings) []string {
+	warnings := make([]string, 0)
+	if err := keymap.Apply(settings.Keys); err != nil {
+		logger.Printf("Error applying key bindings: %v", err)
+		warnings = append(warnings, err.Error())
+	}
+	for _, conflict := range keymap.Conflicts() {
+		logger.Printf("Key binding conflict: %s", conflict)
+		warnings = append(warnings, "Key binding conflict: "+conflict)
+	}
+	return warnings
+}
+
 func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [target-directory]\n", path.Base(os.Args[0]))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
 	tmpDir := os.TempDir()
 	f, err := tea.LogToFile(path.Join(tmpDir, constants.LogFileName), "Main loop")
 	if err != nil {
@@ -27,27 +94,82 @@ func main() {
 
 	targetDirectory := "."
 
-	args := os.Args[1:]
-
-	if len(args) > 0 {
-		targetDirectory, err = files.ExpandAndMakeDir(args[0])
+	if flag.NArg() > 0 {
+		targetDirectory, err = files.ExpandAndMakeDir(flag.Arg(0))
 		if err != nil {
 			logger.Printf("Error making directory: %v", err)
 			os.Exit(1)
 		}
 	}
 
+	settings, err := config.LoadSettings()
+	if err != nil {
+		logger.Printf("Error loading settings: %v", err)
+	}
+	warnings := applyKeys(settings)
+	if *initGit {
+		settings.Hooks = hooks.WithGit(settings.Hooks)
+	}
+
+	if *upgradeDir != "" {
+		if err := runUpgrade(resolveTheme(settings)); err != nil {
+			logger.Printf("Error comparing project: %v", err)
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *headless {
+		if err := runHeadless(targetDirectory, settings); err != nil {
+			logger.Printf("Error generating project: %v", err)
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	conflict, err := templates.ParseConflict(settings.TemplateConflicts)
+	if err != nil {
+		logger.Printf("Error reading template conflicts: %v", err)
+		warnings = append(warnings, err.Error())
+	}
+
+	activeTheme, recolour := resolveTheme(settings)
+	useWizard := settings.Wizard
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "wizard" {
+			useWizard = *wizard
+		}
+	})
+
 	p := tea.NewProgram(mainModel.New(
 		mainModel.WithSpinner(spinner.New(spinner.WithSpinner(spinner.Dot))),
 		mainModel.WithTargetDir(targetDirectory),
+		mainModel.WithProfile(*profileName),
+		mainModel.WithImport(*importPath),
+		mainModel.WithShareUrl(*shareUrl),
+		mainModel.WithWizard(useWizard),
+		mainModel.WithConfirmation(!settings.SkipConfirmation),
+		mainModel.WithHooks(settings.Hooks...),
+		mainModel.WithTemplates(settings.Templates, conflict),
+		mainModel.WithTheme(activeTheme),
+		mainModel.WithWarnings(warnings...),
 	), tea.WithAltScreen(), tea.WithMouseCellMotion())
 
-	colorUpdate := term.ApplyColors(constants.ForegroundColour, constants.BackgroundColour)
-
-	if _, err := p.Run(); err != nil {
+	if err := runProgram(p, activeTheme, recolour); err != nil {
 		logger.Printf("Error occurred in main loop: %v", err)
-		defer os.Exit(1)
+		os.Exit(1)
 	}
+}
 
-	term.ResetColors(colorUpdate)
+// runProgram runs the UI, recolouring the terminal with the theme's
+// colours for the duration of the program when asked to.
+func runProgram(p *tea.Program, t theme.Theme, recolour bool) error {
+	if recolour {
+		colorUpdate := term.ApplyColors(t.Foreground, t.Background)
+		defer term.ResetColors(colorUpdate)
+	}
+	_, err := p.Run()
+	return err
 }
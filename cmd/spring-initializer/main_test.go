@@ -0,0 +1,14 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/eslam-allam/spring-initializer-go/models/keymap"
+)
+
+// The app imports every model, so each of them registered its default keys.
+func TestDefaultKeysDontConflict(t *testing.T) {
+	if conflicts := keymap.Conflicts(); len(conflicts) > 0 {
+		t.Errorf("default key bindings conflict: %v", conflicts)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/eslam-allam/spring-initializer-go/models/diffView"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
+	"github.com/eslam-allam/spring-initializer-go/service/files"
+	"github.com/eslam-allam/spring-initializer-go/service/term"
+	"github.com/eslam-allam/spring-initializer-go/service/upgrade"
+)
+
+// runUpgrade shows the changes regenerating the project given with --upgrade
+// would bring, printing a plain diff in headless mode.
+func runUpgrade(t theme.Theme, recolour bool) error {
+	projectDir, err := files.ExpandPath(*upgradeDir)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, "Loading metadata from spring.io...")
+	u, err := upgrade.New(projectDir)
+	if err != nil {
+		return err
+	}
+	version := u.DefaultBootVersion()
+	if *bootVersion != "" {
+		if version, err = u.ResolveBootVersion(*bootVersion); err != nil {
+			return err
+		}
+	}
+
+	if *headless {
+		diff, err := u.Diff(version)
+		if err != nil {
+			return err
+		}
+		fmt.Print(diff)
+		return nil
+	}
+
+	view := diffView.New(u, version)
+	view.SetTheme(t)
+	if recolour {
+		colorUpdate := term.ApplyColors(t.Foreground, t.Background)
+		defer term.ResetColors(colorUpdate)
+	}
+	_, err = tea.NewProgram(view, tea.WithAltScreen()).Run()
+	return err
+}
@@ -1,22 +1,15 @@
 package constants
 
-const (
-    ForegroundColour string = "#adbac7"
-    BackgroundColour string = "#1a1b26"
-    HighlightColour string = "#BAACBD"
-    MainColour string = "#495867"
-    SecondaryColour string = "#7ae878"
-    SuccessMessageColour string = "#7ae878"
-    FailureMessageColour string = "#f84841"
-)
-
 const (
     MinScreenWidth int = 92
     MinScreenHeight int = 26
+    MinCompactWidth int = 40
+    MinCompactHeight int = 14
 )
 
 const (
     LogFileName = "spring-init.log"
+    ConfigDirName = "spring-initializer"
     SpringUrl = "https://start.spring.io"
     DownloadTimeoutSeconds = 10
 )
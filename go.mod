@@ -12,6 +12,7 @@ require (
 	github.com/muesli/reflow v0.3.0
 	github.com/muesli/termenv v0.15.2
 	golang.design/x/clipboard v0.7.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
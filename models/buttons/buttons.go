@@ -7,8 +7,9 @@ import (
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/eslam-allam/spring-initializer-go/constants"
+	"github.com/eslam-allam/spring-initializer-go/models/keymap"
 	"github.com/eslam-allam/spring-initializer-go/models/notification"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
 )
 
 var logger *log.Logger = log.Default()
@@ -18,6 +19,7 @@ type Action int
 const (
 	DOWNLOAD Action = iota
 	DOWNLOAD_EXTRACT
+	SHARE
 )
 
 type ActionState int
@@ -32,6 +34,8 @@ const (
 type ActionStateMessage struct {
 	Message string
 	State   ActionState
+	// Details explain a failure beyond its message.
+	Details string
 }
 
 var (
@@ -41,6 +45,9 @@ var (
 	downloadExtractCmd tea.Cmd = func() tea.Msg {
 		return DOWNLOAD_EXTRACT
 	}
+	shareCmd tea.Cmd = func() tea.Msg {
+		return SHARE
+	}
 )
 
 type Button struct {
@@ -48,8 +55,14 @@ type Button struct {
 	Action Action
 }
 
+// PressMsg presses the button running Action as if it was submitted.
+type PressMsg struct {
+	Action Action
+}
+
 type Model struct {
 	keys        KeyMap
+	styles      styles
 	buttons     []Button
 	spinner     spinner.Model
 	cursor      int
@@ -67,6 +80,11 @@ func (m Model) FullHelp() [][]key.Binding {
 	return m.keys.FullHelp()
 }
 
+// Buttons returns every button in the order they are shown.
+func (m Model) Buttons() []Button {
+	return m.buttons
+}
+
 func (m *Model) SetSize(h, v int) {
 	m.width = h
 	m.height = v
@@ -78,14 +96,24 @@ func (m Model) GetSize() (h, v int) {
 
 var (
 	buttonStyle lipgloss.Style = lipgloss.NewStyle().Border(lipgloss.NormalBorder(), true).
-			Margin(0, 1).Padding(0, 1)
-	currentButtonStyle lipgloss.Style = lipgloss.NewStyle().Inherit(buttonStyle).Margin(0, 1).
-				Padding(0, 1).
-				BorderForeground(lipgloss.Color(constants.SecondaryColour)).Foreground(lipgloss.Color(constants.SecondaryColour))
-	successMessageStyle lipgloss.Style = lipgloss.NewStyle().Foreground(lipgloss.Color(constants.SuccessMessageColour))
-	failureMessageStyle lipgloss.Style = lipgloss.NewStyle().Foreground(lipgloss.Color(constants.FailureMessageColour))
+		Margin(0, 1).Padding(0, 1)
 )
 
+type styles struct {
+	currentButton lipgloss.Style
+}
+
+func newStyles(t theme.Theme) styles {
+	return styles{
+		currentButton: t.Focused(buttonStyle).Inherit(t.Cursor()).BorderForeground(lipgloss.Color(t.Secondary)),
+	}
+}
+
+// SetTheme restyles the buttons using the colours of the given theme.
+func (m *Model) SetTheme(t theme.Theme) {
+	m.styles = newStyles(t)
+}
+
 func (m Model) View() string {
 	var s string
 
@@ -97,7 +125,7 @@ func (m Model) View() string {
 		buttonDisplay := buttonStyle.Render(b.Name)
 
 		if i == m.cursor {
-			buttonDisplay = currentButtonStyle.Render(b.Name)
+			buttonDisplay = m.styles.currentButton.Render(b.Name)
 		}
 
 		if i == 0 {
@@ -129,9 +157,21 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 				return notification.NotificationMsg{
 					Message: msg.Message,
 					Level:   notification.ERROR,
+					Details: msg.Details,
 				}
 			}
 		}
+	case PressMsg:
+		if m.inAction {
+			return m, cmd
+		}
+		for i, b := range m.buttons {
+			if b.Action == msg.Action {
+				m.cursor = i
+				m, cmd = m.submit()
+				break
+			}
+		}
 	case spinner.TickMsg:
 		if m.inAction {
 			m.spinner, cmd = m.spinner.Update(msg)
@@ -152,14 +192,47 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 				m.cursor--
 			}
 		case key.Matches(msg, m.keys.SUBMIT):
-			cmd = tea.Batch(getCmd(m.buttons[m.cursor].Action), m.spinner.Tick)
-			m.inAction = true
-			m.actionIndex = m.cursor
+			m, cmd = m.submit()
+		}
+	case tea.MouseMsg:
+		if m.inAction || msg.Button != tea.MouseButtonLeft || msg.Action != tea.MouseActionPress {
+			return m, cmd
+		}
+		if index, ok := m.buttonAt(msg.X, msg.Y); ok {
+			m.cursor = index
+			m, cmd = m.submit()
 		}
 	}
 	return m, cmd
 }
 
+func (m Model) submit() (Model, tea.Cmd) {
+	if m.buttons[m.cursor].Action == SHARE {
+		return m, getCmd(SHARE)
+	}
+	m.inAction = true
+	m.actionIndex = m.cursor
+	return m, tea.Batch(getCmd(m.buttons[m.cursor].Action), m.spinner.Tick)
+}
+
+// buttonAt returns the button rendered at the given position of the view,
+// ignoring the margins between buttons.
+func (m Model) buttonAt(x, y int) (int, bool) {
+	top := (m.height - buttonStyle.GetVerticalFrameSize() - 1) / 2
+	if y < top || y > top+buttonStyle.GetVerticalFrameSize() {
+		return 0, false
+	}
+	offset := 0
+	for i, b := range m.buttons {
+		width := lipgloss.Width(buttonStyle.Render(b.Name))
+		if x >= offset+buttonStyle.GetMarginLeft() && x < offset+width-buttonStyle.GetMarginRight() {
+			return i, true
+		}
+		offset += width
+	}
+	return 0, false
+}
+
 func getCmd(action Action) tea.Cmd {
 	var cmd tea.Cmd
 	switch action {
@@ -167,6 +240,8 @@ func getCmd(action Action) tea.Cmd {
 		cmd = downloadCmd
 	case DOWNLOAD_EXTRACT:
 		cmd = downloadExtractCmd
+	case SHARE:
+		cmd = shareCmd
 	}
 	return cmd
 }
@@ -191,9 +266,14 @@ var defaultKeyMap = KeyMap{
 	SUBMIT: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
 }
 
+func init() {
+	keymap.Register("buttons", &defaultKeyMap)
+}
+
 func New(buttons ...Button) Model {
 	return Model{
 		keys:    defaultKeyMap,
+		styles:  newStyles(theme.Default),
 		buttons: buttons,
 		spinner: spinner.New(spinner.WithSpinner(spinner.Dot)),
 	}
@@ -3,6 +3,7 @@ package dependency
 import (
 	"fmt"
 	"log"
+	"slices"
 	"sort"
 	"strings"
 
@@ -11,8 +12,8 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/eslam-allam/spring-initializer-go/constants"
-	"github.com/lithammer/fuzzysearch/fuzzy"
+	"github.com/eslam-allam/spring-initializer-go/models/keymap"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
 	"github.com/muesli/reflow/truncate"
 	"github.com/muesli/reflow/wordwrap"
 )
@@ -20,25 +21,73 @@ import (
 var logger *log.Logger = log.Default()
 
 var (
-	itemStyle        lipgloss.Style = lipgloss.NewStyle()
-	hoverStyle       lipgloss.Style = lipgloss.NewStyle().Foreground(lipgloss.Color(constants.SecondaryColour))
-	descriptionStyle lipgloss.Style = lipgloss.NewStyle().Foreground(lipgloss.Color(constants.HighlightColour))
+	itemStyle    lipgloss.Style = lipgloss.NewStyle()
+	groupStyle   lipgloss.Style = lipgloss.NewStyle().Bold(true)
+	matchedStyle lipgloss.Style = lipgloss.NewStyle().Underline(true).Bold(true)
 )
 
+// row is a line of the list, either a group header or a dependency.
+type row struct {
+	header     bool
+	group      string
+	dependency Dependency
+}
+
+// ToggleMsg adds the dependency matching Id to the selection or removes it.
+type ToggleMsg struct {
+	Id string
+}
+
 type Model struct {
 	Selected        map[string]struct{}
 	filter          string
 	mainKeys        MainKeyMap
+	styles          styles
 	filterKeys      FilterKeyMap
+	summaryKeys     SummaryKeyMap
+	detailKeys      DetailKeyMap
+	detail          Dependency
+	bootVersion     string
 	dependencies    []Dependency
 	filteredDeps    []Dependency
+	matches         map[string][]int
+	rows            []row
+	groups          []string
+	collapsed       map[string]bool
 	filterField     textinput.Model
 	paginate        paginator.Model
 	cursor          int
+	summaryCursor   int
+	detailCursor    int
 	width           int
 	height          int
 	filterToggled   bool
 	showDescription bool
+	showSummary     bool
+	showDetail      bool
+	grouped         bool
+}
+
+type styles struct {
+	hover       lipgloss.Style
+	description lipgloss.Style
+	detail      lipgloss.Style
+}
+
+func newStyles(t theme.Theme) styles {
+	return styles{
+		hover:       t.Cursor(),
+		description: t.Accent(),
+		detail:      t.Focused(detailStyle),
+	}
+}
+
+// SetTheme restyles the list and its overlays using the colours of the
+// given theme.
+func (m *Model) SetTheme(t theme.Theme) {
+	m.styles = newStyles(t)
+	m.paginate.ActiveDot = m.styles.hover.Render("•")
+	m.filterField.PlaceholderStyle = t.Placeholder()
 }
 
 func (m *Model) SetSize(h, v int) {
@@ -54,7 +103,7 @@ func (m *Model) SetSize(h, v int) {
 	}
 
 	m.paginate.PerPage = v - extraLines
-	m.paginate.SetTotalPages(len(m.filteredDeps))
+	m.paginate.SetTotalPages(max(len(m.rows), 1))
 	m.paginate.Page = m.cursor / m.paginate.PerPage
 }
 
@@ -70,14 +119,178 @@ func (m *Model) GetSelectedIds() []string {
 		ids[i] = id
 		i++
 	}
+	sort.Strings(ids)
 	return ids
 }
 
+// Dependencies returns every available dependency.
+func (m Model) Dependencies() []Dependency {
+	return m.dependencies
+}
+
+// Ids returns the ids of every available dependency.
+func (m Model) Ids() []string {
+	ids := make([]string, len(m.dependencies))
+	for i, dep := range m.dependencies {
+		ids[i] = dep.Id
+	}
+	return ids
+}
+
+// SetSelected replaces the current selection with the given ids, returning
+// the ids that don't match any dependency.
+func (m *Model) SetSelected(ids []string) []string {
+	unknown := make([]string, 0)
+	m.Selected = make(map[string]struct{})
+	for _, id := range ids {
+		found := false
+		for _, dep := range m.dependencies {
+			if dep.Id == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, id)
+			continue
+		}
+		m.Selected[id] = struct{}{}
+	}
+	m.buildRows()
+	m.clampSummaryCursor(len(m.Selected))
+	return unknown
+}
+
+// showGroups reports whether the rows are listed under their group headers.
+func (m Model) showGroups() bool {
+	return m.grouped && m.filter == ""
+}
+
+// buildRows lays out the filtered dependencies either under their group
+// headers, in the order of the metadata, or as a flat list with the selected
+// dependencies first followed by the rest alphabetically. While filtering,
+// the results are listed flat in the order of their rank.
+func (m *Model) buildRows() {
+	rows := make([]row, 0, len(m.filteredDeps)+len(m.groups))
+	switch {
+	case m.showGroups():
+		byGroup := make(map[string][]Dependency)
+		for _, dep := range m.filteredDeps {
+			byGroup[dep.GroupName] = append(byGroup[dep.GroupName], dep)
+		}
+		for _, group := range m.groups {
+			deps := byGroup[group]
+			if len(deps) == 0 {
+				continue
+			}
+			rows = append(rows, row{header: true, group: group})
+			if m.collapsed[group] {
+				continue
+			}
+			for _, dep := range deps {
+				rows = append(rows, row{group: group, dependency: dep})
+			}
+		}
+	case m.filter != "":
+		for _, dep := range m.filteredDeps {
+			rows = append(rows, row{group: dep.GroupName, dependency: dep})
+		}
+	default:
+		deps := make([]Dependency, len(m.filteredDeps))
+		copy(deps, m.filteredDeps)
+		sort.SliceStable(deps, func(i, j int) bool {
+			_, ok1 := m.Selected[deps[i].Id]
+			_, ok2 := m.Selected[deps[j].Id]
+			if ok1 != ok2 {
+				return ok1
+			}
+			return deps[i].Name < deps[j].Name
+		})
+		for _, dep := range deps {
+			rows = append(rows, row{group: dep.GroupName, dependency: dep})
+		}
+	}
+
+	m.rows = rows
+	m.paginate.SetTotalPages(max(len(rows), 1))
+	if m.cursor >= len(rows) {
+		m.cursor = max(len(rows)-1, 0)
+	}
+	m.paginate.Page = m.cursor / m.paginate.PerPage
+}
+
+func (m *Model) moveCursor(index int) {
+	m.cursor = index
+	m.paginate.Page = m.cursor / m.paginate.PerPage
+}
+
+// headerIndex returns the row of the given group's header.
+func (m Model) headerIndex(group string) int {
+	for i, r := range m.rows {
+		if r.header && r.group == group {
+			return i
+		}
+	}
+	return 0
+}
+
+// toggleGroup collapses or expands the group under the cursor, keeping the
+// cursor on its header.
+func (m *Model) toggleGroup() {
+	if !m.showGroups() || len(m.rows) == 0 {
+		return
+	}
+	group := m.rows[m.cursor].group
+	m.collapsed[group] = !m.collapsed[group]
+	m.buildRows()
+	m.moveCursor(m.headerIndex(group))
+}
+
+// toggleAllGroups collapses every group unless they are all collapsed
+// already, in which case they are expanded.
+func (m *Model) toggleAllGroups() {
+	if !m.showGroups() || len(m.rows) == 0 {
+		return
+	}
+	group := m.rows[m.cursor].group
+	collapse := false
+	for _, g := range m.groups {
+		if !m.collapsed[g] {
+			collapse = true
+			break
+		}
+	}
+	for _, g := range m.groups {
+		m.collapsed[g] = collapse
+	}
+	m.buildRows()
+	m.moveCursor(m.headerIndex(group))
+}
+
+// jumpGroup moves the cursor to the header of the next or previous group.
+func (m *Model) jumpGroup(forward bool) {
+	if !m.showGroups() {
+		return
+	}
+	step := 1
+	if !forward {
+		step = -1
+	}
+	for i := m.cursor + step; i >= 0 && i < len(m.rows); i += step {
+		if m.rows[i].header {
+			m.moveCursor(i)
+			return
+		}
+	}
+}
+
 type Dependency struct {
-	Id          string
-	Name        string
-	GroupName   string
-	Description string
+	Id            string
+	Name          string
+	GroupName     string
+	Description   string
+	Compatibility string
+	Links         []Link
 }
 
 type MainKeyMap struct {
@@ -85,8 +298,15 @@ type MainKeyMap struct {
 	Down              key.Binding
 	PagePrev          key.Binding
 	PageNext          key.Binding
+	NextGroup         key.Binding
+	PrevGroup         key.Binding
 	ToggleSelect      key.Binding
 	ToggleDescription key.Binding
+	ToggleGroup       key.Binding
+	ToggleAllGroups   key.Binding
+	ToggleView        key.Binding
+	ToggleSummary     key.Binding
+	ShowDetail        key.Binding
 	Filter            key.Binding
 }
 
@@ -98,8 +318,11 @@ func (k MainKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Up, k.Down},
 		{k.PagePrev, k.PageNext},
+		{k.PrevGroup, k.NextGroup},
 		{k.ToggleSelect, k.Filter},
-		{k.ToggleDescription},
+		{k.ToggleGroup, k.ToggleAllGroups},
+		{k.ToggleDescription, k.ToggleView},
+		{k.ToggleSummary, k.ShowDetail},
 	}
 }
 
@@ -108,8 +331,15 @@ var defaultMainKeys = MainKeyMap{
 	Down:              key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "move down")),
 	PagePrev:          key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "previous page")),
 	PageNext:          key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "next page")),
+	NextGroup:         key.NewBinding(key.WithKeys("]"), key.WithHelp("]", "next group")),
+	PrevGroup:         key.NewBinding(key.WithKeys("["), key.WithHelp("[", "previous group")),
 	ToggleSelect:      key.NewBinding(key.WithKeys("enter", " "), key.WithHelp("enter/space", "toggle selection")),
 	ToggleDescription: key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "toggle description")),
+	ToggleGroup:       key.NewBinding(key.WithKeys("c"), key.WithHelp("c", "collapse/expand group")),
+	ToggleAllGroups:   key.NewBinding(key.WithKeys("C"), key.WithHelp("C", "collapse/expand all")),
+	ToggleView:        key.NewBinding(key.WithKeys("g"), key.WithHelp("g", "grouped/flat view")),
+	ToggleSummary:     key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "selected summary")),
+	ShowDetail:        key.NewBinding(key.WithKeys("i"), key.WithHelp("i", "details and links")),
 	Filter:            key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
 }
 
@@ -131,7 +361,17 @@ var defaultFilterKeys = FilterKeyMap{
 	Cancel: key.NewBinding(key.WithKeys("esc", "ctrl+c"), key.WithHelp("esc", "cancel")),
 }
 
+func init() {
+	keymap.Register("dependencies", &defaultMainKeys)
+	keymap.Register("dependency-filter", &defaultFilterKeys)
+	keymap.Register("dependency-summary", &defaultSummaryKeys)
+	keymap.Register("dependency-detail", &defaultDetailKeys)
+}
+
 func (m Model) View() string {
+	if m.showSummary {
+		return m.summaryView()
+	}
 	body := m.bodyView()
 	body = lipgloss.Place(m.width, m.height, lipgloss.Left, lipgloss.Top, body)
 	paginator := m.paginate.View()
@@ -145,10 +385,20 @@ func (m Model) View() string {
 	if lipgloss.Width(filter) > m.width {
 		filter = truncate.StringWithTail(filter, uint(m.width), "…")
 	}
-	return lipgloss.JoinVertical(lipgloss.Left, body, filter)
+	body = lipgloss.JoinVertical(lipgloss.Left, body, filter)
+	if m.showDetail {
+		return m.detailView(body)
+	}
+	return body
 }
 
 func (m Model) ShortHelp() []key.Binding {
+	if m.showDetail {
+		return m.detailKeys.ShortHelp()
+	}
+	if m.showSummary {
+		return m.summaryKeys.ShortHelp()
+	}
 	if m.filterToggled {
 		return m.filterKeys.ShortHelp()
 	}
@@ -156,34 +406,82 @@ func (m Model) ShortHelp() []key.Binding {
 }
 
 func (m Model) FullHelp() [][]key.Binding {
+	if m.showDetail {
+		return m.detailKeys.FullHelp()
+	}
+	if m.showSummary {
+		return m.summaryKeys.FullHelp()
+	}
 	if m.filterToggled {
 		return m.filterKeys.FullHelp()
 	}
 	return m.mainKeys.FullHelp()
 }
 
+func (m Model) headerView(group string, isCurrent bool) string {
+	total, selected := 0, 0
+	for _, dep := range m.dependencies {
+		if dep.GroupName != group {
+			continue
+		}
+		total++
+		if _, ok := m.Selected[dep.Id]; ok {
+			selected++
+		}
+	}
+
+	marker := "▾"
+	if m.collapsed[group] {
+		marker = "▸"
+	}
+	display := fmt.Sprintf("%s %s (%d/%d)", marker, group, selected, total)
+	if isCurrent {
+		return m.styles.hover.Copy().Inherit(groupStyle).Render(display)
+	}
+	return groupStyle.Render(display)
+}
+
 func (m Model) bodyView() string {
 	body := strings.Builder{}
-	start, end := m.paginate.GetSliceBounds(len(m.filteredDeps))
-	for i, item := range m.filteredDeps[start:end] {
+	start, end := m.paginate.GetSliceBounds(len(m.rows))
+	for i, r := range m.rows[start:end] {
 		currentIndex := i + start
+		if r.header {
+			header := m.headerView(r.group, currentIndex == m.cursor)
+			if lipgloss.Width(header) > m.width {
+				header = truncate.StringWithTail(header, uint(m.width-1), "…")
+			}
+			body.WriteString(header)
+			if i != m.paginate.PerPage-1 {
+				body.WriteString("\n")
+			}
+			continue
+		}
+
+		item := r.dependency
+		if m.showGroups() {
+			body.WriteString("  ")
+		}
 		if _, ok := m.Selected[item.Id]; ok {
 			body.WriteString("[✓] ")
 		} else {
 			body.WriteString("[ ] ")
 		}
 
-		itemDisplay := itemStyle.Render(item.Name)
+		itemDisplay := highlight(item.Name, m.matches[item.Id], itemStyle)
 		if currentIndex == m.cursor {
-			itemDisplay = hoverStyle.Render(item.Name)
+			itemDisplay = highlight(item.Name, m.matches[item.Id], m.styles.hover)
 			if m.showDescription {
-				itemDisplay = lipgloss.JoinVertical(lipgloss.Left, itemDisplay,
-					descriptionStyle.MaxWidth(m.width-5).MaxHeight(3).PaddingLeft(4).Render(wordwrap.String(item.Description, m.width-10)))
+				itemDisplay = lipgloss.JoinVertical(lipgloss.Left, itemDisplay, m.descriptionView(item))
 			}
 		}
 
-		if lipgloss.Width(itemDisplay) > m.width-4 {
-			itemDisplay = truncate.StringWithTail(itemDisplay, uint(m.width-5), "…")
+		indent := 4
+		if m.showGroups() {
+			indent += 2
+		}
+		if lipgloss.Width(itemDisplay) > m.width-indent {
+			itemDisplay = truncate.StringWithTail(itemDisplay, uint(m.width-indent-1), "…")
 		}
 		body.WriteString(itemDisplay)
 
@@ -194,6 +492,28 @@ func (m Model) bodyView() string {
 	return lipgloss.Place(m.width, m.height, lipgloss.Left, lipgloss.Top, body.String())
 }
 
+func (m Model) descriptionView(item Dependency) string {
+	return m.styles.description.Copy().MaxWidth(m.width - 5).MaxHeight(3).PaddingLeft(4).Render(wordwrap.String(item.Description, m.width-10))
+}
+
+// rowAt returns the row rendered at the given line of the body, taking the
+// description shown under the cursor into account.
+func (m Model) rowAt(y int) (int, bool) {
+	start, end := m.paginate.GetSliceBounds(len(m.rows))
+	line := 0
+	for i := start; i < end; i++ {
+		height := 1
+		if i == m.cursor && m.showDescription && !m.rows[i].header {
+			height += lipgloss.Height(m.descriptionView(m.rows[i].dependency))
+		}
+		if y >= line && y < line+height {
+			return i, true
+		}
+		line += height
+	}
+	return 0, false
+}
+
 func (m Model) Init() tea.Cmd {
 	return nil
 }
@@ -220,7 +540,7 @@ func (m Model) updateMain(msg tea.KeyMsg) (Model, tea.Cmd) {
 
 	// The "down" and "j" keys move the cursor down
 	case key.Matches(msg, m.mainKeys.Down):
-		if m.cursor < len(m.filteredDeps)-1 {
+		if m.cursor < len(m.rows)-1 {
 			m.cursor++
 			m.paginate.Page = m.cursor / m.paginate.PerPage
 		}
@@ -237,39 +557,127 @@ func (m Model) updateMain(msg tea.KeyMsg) (Model, tea.Cmd) {
 			m.cursor = m.paginate.Page * m.paginate.PerPage
 		}
 
+	case key.Matches(msg, m.mainKeys.NextGroup):
+		m.jumpGroup(true)
+
+	case key.Matches(msg, m.mainKeys.PrevGroup):
+		m.jumpGroup(false)
+
+	case key.Matches(msg, m.mainKeys.ToggleGroup):
+		m.toggleGroup()
+
+	case key.Matches(msg, m.mainKeys.ToggleAllGroups):
+		m.toggleAllGroups()
+
+	case key.Matches(msg, m.mainKeys.ShowDetail):
+		if len(m.rows) == 0 || m.rows[m.cursor].header {
+			break
+		}
+		m.detail = m.rows[m.cursor].dependency
+		m.detailCursor = 0
+		m.showDetail = true
+
+	case key.Matches(msg, m.mainKeys.ToggleSummary):
+		m.showSummary = true
+		m.summaryCursor = 0
+
+	case key.Matches(msg, m.mainKeys.ToggleView):
+		m.grouped = !m.grouped
+		m.cursor = 0
+		m.buildRows()
+
 	// The "enter" key and the spacebar (a literal space) toggle
-	// the selected state for the item that the cursor is pointing at.
+	// the selected state for the item that the cursor is pointing at,
+	// or collapse the group when on its header.
 	case key.Matches(msg, m.mainKeys.ToggleSelect):
-		currentId := m.filteredDeps[m.cursor].Id
-		if _, ok := m.Selected[currentId]; ok {
-			delete(m.Selected, currentId)
-		} else {
-			m.Selected[currentId] = struct{}{}
+		m.toggleSelect()
+	}
+	return m, nil
+}
+
+func (m *Model) toggleSelect() {
+	if len(m.rows) == 0 {
+		return
+	}
+	if m.rows[m.cursor].header {
+		m.toggleGroup()
+		return
+	}
+	currentId := m.rows[m.cursor].dependency.Id
+	if _, ok := m.Selected[currentId]; ok {
+		delete(m.Selected, currentId)
+	} else {
+		m.Selected[currentId] = struct{}{}
+	}
+	m.buildRows()
+}
+
+func (m Model) updateMainMouse(msg tea.MouseMsg) (Model, tea.Cmd) {
+	switch {
+	case msg.Button == tea.MouseButtonWheelUp:
+		if m.cursor > 0 {
+			m.moveCursor(m.cursor - 1)
 		}
-		sort.Slice(m.dependencies, func(i, j int) bool {
-			_, ok1 := m.Selected[m.dependencies[i].Id]
-			_, ok2 := m.Selected[m.dependencies[j].Id]
-			if ok1 && !ok2 {
-				return true
-			}
-			if !ok1 && ok2 {
-				return false
+
+	case msg.Button == tea.MouseButtonWheelDown:
+		if m.cursor < len(m.rows)-1 {
+			m.moveCursor(m.cursor + 1)
+		}
+
+	case msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionPress:
+		// The filter sits under the paginator, right below the body.
+		if msg.Y == m.height+1 {
+			m.filterToggled = !m.filterToggled
+			if m.filterToggled {
+				return m, m.filterField.Focus()
 			}
-			return m.dependencies[i].Name < m.dependencies[j].Name
-		})
+			m.filterField.Blur()
+			break
+		}
+		if index, ok := m.rowAt(msg.Y); ok {
+			m.cursor = index
+			m.toggleSelect()
+		}
 	}
 	return m, nil
 }
 
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case ToggleMsg:
+		if _, ok := m.Selected[msg.Id]; ok {
+			delete(m.Selected, msg.Id)
+		} else if slices.Contains(m.Ids(), msg.Id) {
+			m.Selected[msg.Id] = struct{}{}
+		}
+		m.buildRows()
+
 	case tea.KeyMsg:
 
 		if m.filterToggled {
 			return updateFilter(msg, m)
 		}
 
+		if m.showDetail {
+			return m.updateDetail(msg)
+		}
+
+		if m.showSummary {
+			return m.updateSummary(msg)
+		}
+
 		return m.updateMain(msg)
+
+	case tea.MouseMsg:
+		if m.showDetail {
+			return m.updateDetailMouse(msg)
+		}
+
+		if m.showSummary {
+			return m.updateSummaryMouse(msg)
+		}
+
+		return m.updateMainMouse(msg)
 	}
 	return m, nil
 }
@@ -287,8 +695,8 @@ func updateFilter(msg tea.KeyMsg, m Model) (Model, tea.Cmd) {
 		m.filterField.Reset()
 		m.cursor = 0
 		m.filteredDeps = m.dependencies
-		m.paginate.SetTotalPages(len(m.filteredDeps))
-		m.paginate.Page = 0
+		m.matches = nil
+		m.buildRows()
 		m.filterField.Blur()
 	}
 
@@ -301,43 +709,48 @@ func updateFilter(msg tea.KeyMsg, m Model) (Model, tea.Cmd) {
 
 	m.filter = newFilter
 
-	m.filteredDeps = filterDeps(m.dependencies, m.filter)
-	totalItems := len(m.filteredDeps)
-
-	if totalItems == 0 {
-		m.paginate.TotalPages = 1
-	} else {
-		m.paginate.SetTotalPages(len(m.filteredDeps))
-	}
-	m.paginate.Page = 0
+	m.filteredDeps, m.matches = filterDeps(m.dependencies, m.filter)
 	m.cursor = 0
+	m.buildRows()
 
 	return m, nil
 }
 
-func filterDeps(deps []Dependency, value string) []Dependency {
-	filtered := make([]Dependency, 0)
-	for _, dep := range deps {
-		if fuzzy.MatchFold(value, dep.Name) {
-			filtered = append(filtered, dep)
-		}
+// filterDeps returns the dependencies matching value ranked by relevance,
+// along with the matched positions in the name of each of them.
+func filterDeps(deps []Dependency, value string) ([]Dependency, map[string][]int) {
+	if strings.TrimSpace(value) == "" {
+		return deps, nil
+	}
+	results := searchDeps(deps, value)
+	filtered := make([]Dependency, len(results))
+	matches := make(map[string][]int, len(results))
+	for i, result := range results {
+		filtered[i] = result.dependency
+		matches[result.dependency.Id] = result.indices
 	}
-	return filtered
+	return filtered, matches
 }
 
+// New creates a dependency list grouped like the metadata. The dependencies
+// are expected in metadata order.
 func New(dependencies ...Dependency) Model {
-	sort.Slice(dependencies, func(i, j int) bool {
-		return dependencies[i].Name < dependencies[j].Name
-	})
+	groups := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, dep := range dependencies {
+		if _, ok := seen[dep.GroupName]; !ok {
+			seen[dep.GroupName] = struct{}{}
+			groups = append(groups, dep.GroupName)
+		}
+	}
 
 	filterField := textinput.New()
-	filterField.Placeholder = "Type here to filter dependencies..."
+	filterField.Placeholder = "Search by name, id, group or description..."
 
 	p := paginator.New()
 	p.Type = paginator.Dots
 	p.PerPage = 20
 	p.InactiveDot = lipgloss.NewStyle().Render("•")
-	p.ActiveDot = lipgloss.NewStyle().Foreground(lipgloss.Color(constants.SecondaryColour)).Render(p.ActiveDot)
 	p.SetTotalPages(len(dependencies))
 
 	model := Model{
@@ -345,10 +758,17 @@ func New(dependencies ...Dependency) Model {
 		filterField:  filterField,
 		dependencies: dependencies,
 		filteredDeps: dependencies,
+		groups:       groups,
+		collapsed:    make(map[string]bool),
+		grouped:      true,
 		paginate:     p,
 		mainKeys:     defaultMainKeys,
 		filterKeys:   defaultFilterKeys,
+		summaryKeys:  defaultSummaryKeys,
+		detailKeys:   defaultDetailKeys,
 	}
+	model.SetTheme(theme.Default)
+	model.buildRows()
 
 	return model
 }
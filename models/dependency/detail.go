@@ -0,0 +1,159 @@
+package dependency
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/eslam-allam/spring-initializer-go/models/notification"
+	"github.com/eslam-allam/spring-initializer-go/models/overlay"
+	"github.com/eslam-allam/spring-initializer-go/service/browser"
+	"github.com/muesli/reflow/truncate"
+	"github.com/muesli/reflow/wordwrap"
+	"golang.design/x/clipboard"
+)
+
+var detailStyle lipgloss.Style = lipgloss.NewStyle().Padding(0, 1).Border(lipgloss.NormalBorder(), true)
+
+type Link struct {
+	Rel   string
+	Title string
+	Href  string
+}
+
+type DetailKeyMap struct {
+	Up    key.Binding
+	Down  key.Binding
+	Copy  key.Binding
+	Open  key.Binding
+	Close key.Binding
+}
+
+func (k DetailKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{}
+}
+
+func (k DetailKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Up, k.Down}, {k.Copy, k.Open}, {k.Close}}
+}
+
+var defaultDetailKeys = DetailKeyMap{
+	Up:    key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "previous link")),
+	Down:  key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "next link")),
+	Copy:  key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "copy link")),
+	Open:  key.NewBinding(key.WithKeys("o", "enter"), key.WithHelp("o/enter", "open link")),
+	Close: key.NewBinding(key.WithKeys("esc", "i"), key.WithHelp("esc/i", "close details")),
+}
+
+// SetBootVersion sets the boot version substituted into templated links.
+func (m *Model) SetBootVersion(version string) {
+	m.bootVersion = version
+}
+
+func (m Model) linkHref(link Link) string {
+	return strings.ReplaceAll(link.Href, "{bootVersion}", m.bootVersion)
+}
+
+func (m Model) detailView(body string) string {
+	dep := m.detail
+	innerWidth := m.width - detailStyle.GetHorizontalFrameSize()
+
+	lines := []string{
+		groupStyle.Render(dep.Name),
+		m.styles.description.Render(fmt.Sprintf("%s · %s", dep.GroupName, dep.Id)),
+		"",
+		wordwrap.String(dep.Description, innerWidth),
+		"",
+		fmt.Sprintf("Spring Boot: %s", dep.Compatibility),
+	}
+	if len(dep.Links) > 0 {
+		lines = append(lines, "", groupStyle.Render("Links"))
+	}
+	for i, link := range dep.Links {
+		display := fmt.Sprintf("%s: %s", link.Title, m.linkHref(link))
+		if i == m.detailCursor {
+			display = m.styles.hover.Render("> " + display)
+		} else {
+			display = "  " + display
+		}
+		if lipgloss.Width(display) > innerWidth {
+			display = truncate.StringWithTail(display, uint(innerWidth), "…")
+		}
+		lines = append(lines, display)
+	}
+
+	// The list body is two lines taller than its height because of the
+	// paginator and the filter.
+	content := lipgloss.NewStyle().MaxHeight(m.height + 2 - detailStyle.GetVerticalFrameSize()).
+		Render(strings.Join(lines, "\n"))
+	detail := m.styles.detail.Copy().Width(m.width - detailStyle.GetHorizontalBorderSize()).Render(content)
+	detail = overlay.PlaceTitle("Details", detail, 0, 0, 1)
+	return overlay.PlaceOverlay(0, 0, detail, body)
+}
+
+func (m Model) updateDetail(msg tea.KeyMsg) (Model, tea.Cmd) {
+	links := m.detail.Links
+	switch {
+	case key.Matches(msg, m.detailKeys.Close):
+		m.showDetail = false
+
+	case key.Matches(msg, m.detailKeys.Up):
+		if m.detailCursor > 0 {
+			m.detailCursor--
+		}
+
+	case key.Matches(msg, m.detailKeys.Down):
+		if m.detailCursor < len(links)-1 {
+			m.detailCursor++
+		}
+
+	case key.Matches(msg, m.detailKeys.Copy):
+		if len(links) == 0 {
+			break
+		}
+		href := m.linkHref(links[m.detailCursor])
+		if err := clipboard.Init(); err != nil {
+			logger.Printf("Error initializing clipboard: %v", err)
+			return m, notify(fmt.Sprintf("Clipboard is not available: %s", err), notification.ERROR)
+		}
+		clipboard.Write(clipboard.FmtText, []byte(href))
+		return m, notify(fmt.Sprintf("Copied %s", href), notification.INFO)
+
+	case key.Matches(msg, m.detailKeys.Open):
+		if len(links) == 0 {
+			break
+		}
+		href := m.linkHref(links[m.detailCursor])
+		if err := browser.Open(href); err != nil {
+			logger.Printf("Error opening link: %v", err)
+			return m, notify(fmt.Sprintf("Failed to open %s: %s", href, err), notification.ERROR)
+		}
+	}
+	return m, nil
+}
+
+func (m Model) updateDetailMouse(msg tea.MouseMsg) (Model, tea.Cmd) {
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		if m.detailCursor > 0 {
+			m.detailCursor--
+		}
+
+	case tea.MouseButtonWheelDown:
+		if m.detailCursor < len(m.detail.Links)-1 {
+			m.detailCursor++
+		}
+	}
+	return m, nil
+}
+
+func notify(message string, level notification.NotificationLevel) tea.Cmd {
+	return func() tea.Msg {
+		return notification.NotificationMsg{
+			Message: message,
+			Level:   level,
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package dependency
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lithammer/fuzzysearch/fuzzy"
+)
+
+// Penalties added to the score of a term matched outside of the name so that
+// name matches always rank first. Groups and descriptions are only matched
+// as substrings since fuzzy matching long text matches nearly anything.
+const (
+	idPenalty          = 100
+	groupPenalty       = 200
+	descriptionPenalty = 300
+)
+
+// searchResult is a dependency matching the filter along with its score,
+// lower being better, and the positions of the matched runes in its name.
+type searchResult struct {
+	dependency Dependency
+	score      int
+	indices    []int
+}
+
+// matchIndices returns the rune positions of target matched by the
+// characters of term in order, or nil if term isn't a subsequence of target.
+func matchIndices(term, target string) []int {
+	termRunes := []rune(strings.ToLower(term))
+	indices := make([]int, 0, len(termRunes))
+	j := 0
+	for i, r := range []rune(target) {
+		if j == len(termRunes) {
+			break
+		}
+		if unicode.ToLower(r) == termRunes[j] {
+			indices = append(indices, i)
+			j++
+		}
+	}
+	if j < len(termRunes) {
+		return nil
+	}
+	return indices
+}
+
+// scoreTerm returns the best score of a single search term against the
+// fields of dep, and the name positions it matched if it matched the name.
+func scoreTerm(term string, dep Dependency) (int, []int, bool) {
+	if distance := fuzzy.RankMatchFold(term, dep.Name); distance >= 0 {
+		return distance, matchIndices(term, dep.Name), true
+	}
+	if distance := fuzzy.RankMatchFold(term, dep.Id); distance >= 0 {
+		return distance + idPenalty, nil, true
+	}
+	lowerTerm := strings.ToLower(term)
+	if index := strings.Index(strings.ToLower(dep.GroupName), lowerTerm); index >= 0 {
+		return index + groupPenalty, nil, true
+	}
+	if index := strings.Index(strings.ToLower(dep.Description), lowerTerm); index >= 0 {
+		return index + descriptionPenalty, nil, true
+	}
+	return 0, nil, false
+}
+
+// searchDeps returns the dependencies matching every whitespace separated
+// term of value, best matches first.
+func searchDeps(deps []Dependency, value string) []searchResult {
+	terms := strings.Fields(value)
+	results := make([]searchResult, 0)
+	for _, dep := range deps {
+		result := searchResult{dependency: dep}
+		matched := true
+		for _, term := range terms {
+			score, indices, ok := scoreTerm(term, dep)
+			if !ok {
+				matched = false
+				break
+			}
+			result.score += score
+			result.indices = append(result.indices, indices...)
+		}
+		if matched {
+			results = append(results, result)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].score != results[j].score {
+			return results[i].score < results[j].score
+		}
+		return results[i].dependency.Name < results[j].dependency.Name
+	})
+	return results
+}
+
+// highlight renders s with style, underlining the runes at the given
+// positions.
+func highlight(s string, indices []int, style lipgloss.Style) string {
+	if len(indices) == 0 {
+		return style.Render(s)
+	}
+	matched := make(map[int]struct{}, len(indices))
+	for _, i := range indices {
+		matched[i] = struct{}{}
+	}
+	matchStyle := style.Copy().Inherit(matchedStyle)
+
+	b := strings.Builder{}
+	for i, r := range []rune(s) {
+		if _, ok := matched[i]; ok {
+			b.WriteString(matchStyle.Render(string(r)))
+		} else {
+			b.WriteString(style.Render(string(r)))
+		}
+	}
+	return b.String()
+}
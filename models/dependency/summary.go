@@ -0,0 +1,166 @@
+package dependency
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
+)
+
+type SummaryKeyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	Remove   key.Binding
+	ClearAll key.Binding
+	Close    key.Binding
+}
+
+func (k SummaryKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{}
+}
+
+func (k SummaryKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Up, k.Down}, {k.Remove, k.ClearAll}, {k.Close}}
+}
+
+var defaultSummaryKeys = SummaryKeyMap{
+	Up:       key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "move up")),
+	Down:     key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "move down")),
+	Remove:   key.NewBinding(key.WithKeys("x", "delete", "enter", " "), key.WithHelp("x/enter", "remove")),
+	ClearAll: key.NewBinding(key.WithKeys("X"), key.WithHelp("X", "clear all")),
+	Close:    key.NewBinding(key.WithKeys("s", "esc"), key.WithHelp("s/esc", "back to list")),
+}
+
+// SelectedCount returns the number of selected dependencies.
+func (m Model) SelectedCount() int {
+	return len(m.Selected)
+}
+
+// SelectedNames returns the names of the selected dependencies sorted
+// alphabetically.
+func (m Model) SelectedNames() []string {
+	deps := m.selectedDeps()
+	names := make([]string, len(deps))
+	for i, dep := range deps {
+		names[i] = dep.Name
+	}
+	return names
+}
+
+// selectedDeps returns the selected dependencies sorted by name.
+func (m Model) selectedDeps() []Dependency {
+	deps := make([]Dependency, 0, len(m.Selected))
+	for _, dep := range m.dependencies {
+		if _, ok := m.Selected[dep.Id]; ok {
+			deps = append(deps, dep)
+		}
+	}
+	sort.Slice(deps, func(i, j int) bool {
+		return deps[i].Name < deps[j].Name
+	})
+	return deps
+}
+
+// clampSummaryCursor keeps the cursor of the summary on one of the count
+// selected dependencies, as the selection can shrink while it is open.
+func (m *Model) clampSummaryCursor(count int) {
+	m.summaryCursor = max(min(m.summaryCursor, count-1), 0)
+}
+
+func (m Model) summaryView() string {
+	deps := m.selectedDeps()
+	m.clampSummaryCursor(len(deps))
+	if len(deps) == 0 {
+		return lipgloss.Place(m.width, m.height+2, lipgloss.Center, lipgloss.Center,
+			m.styles.description.Render("No dependencies selected"))
+	}
+
+	perPage := max(m.height+1, 1)
+	start := (m.summaryCursor / perPage) * perPage
+	end := min(start+perPage, len(deps))
+
+	body := strings.Builder{}
+	for i, dep := range deps[start:end] {
+		display := itemStyle.Render(dep.Name)
+		if start+i == m.summaryCursor {
+			display = m.styles.hover.Render(dep.Name)
+		}
+		display = fmt.Sprintf("[✓] %s %s", display, m.styles.description.Render("· "+dep.GroupName))
+		if lipgloss.Width(display) > m.width {
+			display = truncate.StringWithTail(display, uint(m.width-1), "…")
+		}
+		body.WriteString(display)
+		if i < end-start-1 {
+			body.WriteString("\n")
+		}
+	}
+	title := groupStyle.Render(fmt.Sprintf("Selected (%d)", len(deps)))
+	return lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.Place(m.width, m.height+1, lipgloss.Left, lipgloss.Top, body.String()), title)
+}
+
+func (m Model) updateSummary(msg tea.KeyMsg) (Model, tea.Cmd) {
+	deps := m.selectedDeps()
+	m.clampSummaryCursor(len(deps))
+	switch {
+	case key.Matches(msg, m.summaryKeys.Close):
+		m.showSummary = false
+
+	case key.Matches(msg, m.summaryKeys.Up):
+		if m.summaryCursor > 0 {
+			m.summaryCursor--
+		}
+
+	case key.Matches(msg, m.summaryKeys.Down):
+		if m.summaryCursor < len(deps)-1 {
+			m.summaryCursor++
+		}
+
+	case key.Matches(msg, m.summaryKeys.Remove):
+		if len(deps) == 0 {
+			break
+		}
+		delete(m.Selected, deps[m.summaryCursor].Id)
+		if m.summaryCursor >= len(deps)-1 {
+			m.summaryCursor = max(len(deps)-2, 0)
+		}
+		m.buildRows()
+
+	case key.Matches(msg, m.summaryKeys.ClearAll):
+		m.Selected = make(map[string]struct{})
+		m.summaryCursor = 0
+		m.buildRows()
+	}
+	return m, nil
+}
+
+func (m Model) updateSummaryMouse(msg tea.MouseMsg) (Model, tea.Cmd) {
+	deps := m.selectedDeps()
+	m.clampSummaryCursor(len(deps))
+	switch {
+	case msg.Button == tea.MouseButtonWheelUp:
+		if m.summaryCursor > 0 {
+			m.summaryCursor--
+		}
+
+	case msg.Button == tea.MouseButtonWheelDown:
+		if m.summaryCursor < len(deps)-1 {
+			m.summaryCursor++
+		}
+
+	case msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionPress:
+		perPage := max(m.height+1, 1)
+		index := (m.summaryCursor/perPage)*perPage + msg.Y
+		if msg.Y < 0 || msg.Y >= perPage || index >= len(deps) {
+			break
+		}
+		delete(m.Selected, deps[index].Id)
+		m.summaryCursor = min(index, max(len(deps)-2, 0))
+		m.buildRows()
+	}
+	return m, nil
+}
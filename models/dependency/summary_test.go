@@ -0,0 +1,87 @@
+package dependency
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newSummaryModel(t *testing.T) Model {
+	t.Helper()
+	m := New(
+		Dependency{Id: "web", Name: "Spring Web", GroupName: "Web"},
+		Dependency{Id: "jpa", Name: "Spring Data JPA", GroupName: "SQL"},
+		Dependency{Id: "lombok", Name: "Lombok", GroupName: "Developer Tools"},
+	)
+	m.SetSize(60, 20)
+	if unknown := m.SetSelected([]string{"web", "jpa", "lombok"}); len(unknown) > 0 {
+		t.Fatalf("SetSelected() unknown = %v", unknown)
+	}
+	m, _ = m.Update(keyMsg("s"))
+	if !m.showSummary {
+		t.Fatal("s did not open the summary")
+	}
+	m, _ = m.Update(keyMsg("down"))
+	m, _ = m.Update(keyMsg("down"))
+	if m.summaryCursor != 2 {
+		t.Fatalf("summaryCursor = %d, want 2", m.summaryCursor)
+	}
+	return m
+}
+
+func keyMsg(k string) tea.KeyMsg {
+	switch k {
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	case "up":
+		return tea.KeyMsg{Type: tea.KeyUp}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+}
+
+func TestSummaryRemove(t *testing.T) {
+	m := newSummaryModel(t)
+	m, _ = m.Update(keyMsg("up"))
+	m, _ = m.Update(keyMsg("x"))
+
+	if got, want := m.SelectedNames(), []string{"Lombok", "Spring Web"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SelectedNames() = %v, want %v", got, want)
+	}
+	if m.summaryCursor != 1 {
+		t.Errorf("summaryCursor = %d, want 1", m.summaryCursor)
+	}
+}
+
+func TestSummaryShrinksUnderCursor(t *testing.T) {
+	shrinks := []struct {
+		name   string
+		shrink func(m *Model)
+	}{
+		{"SetSelected", func(m *Model) { m.SetSelected([]string{"web"}) }},
+		{"toggle", func(m *Model) {
+			*m, _ = m.Update(ToggleMsg{Id: "jpa"})
+			*m, _ = m.Update(ToggleMsg{Id: "lombok"})
+		}},
+		{"cleared", func(m *Model) { m.Selected = make(map[string]struct{}) }},
+	}
+	for _, tt := range shrinks {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newSummaryModel(t)
+			tt.shrink(&m)
+
+			m.View()
+			m, _ = m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+			m, _ = m.Update(keyMsg("down"))
+			m, _ = m.Update(keyMsg("x"))
+			m.View()
+
+			if m.SelectedCount() != 0 {
+				t.Errorf("SelectedCount() = %d, want 0", m.SelectedCount())
+			}
+			if m.summaryCursor != 0 {
+				t.Errorf("summaryCursor = %d, want 0", m.summaryCursor)
+			}
+		})
+	}
+}
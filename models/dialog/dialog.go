@@ -0,0 +1,274 @@
+package dialog
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/eslam-allam/spring-initializer-go/models/keymap"
+	"github.com/eslam-allam/spring-initializer-go/models/overlay"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
+	"github.com/muesli/reflow/wordwrap"
+	"github.com/muesli/reflow/wrap"
+)
+
+var (
+	dialogStyle lipgloss.Style = lipgloss.NewStyle().Padding(1).Border(lipgloss.NormalBorder(), true)
+	buttonStyle lipgloss.Style = lipgloss.NewStyle().Border(lipgloss.NormalBorder(), true).
+			MarginLeft(1).Padding(0, 1)
+)
+
+type styles struct {
+	dialog        lipgloss.Style
+	currentButton lipgloss.Style
+	checkbox      lipgloss.Style
+}
+
+func newStyles(t theme.Theme) styles {
+	return styles{
+		dialog:        t.Focused(dialogStyle),
+		currentButton: t.Focused(buttonStyle).Inherit(t.Cursor()).BorderForeground(lipgloss.Color(t.Secondary)),
+		checkbox:      t.Muted(),
+	}
+}
+
+// SetTheme restyles the dialog using the colours of the given theme.
+func (m *Model) SetTheme(t theme.Theme) {
+	m.styles = newStyles(t)
+}
+
+// ResultMsg is sent when the dialog identified by Dialog is closed.
+type ResultMsg struct {
+	Dialog    string
+	Confirmed bool
+	// DontAskAgain is set when "Don't ask again" was checked before
+	// confirming.
+	DontAskAgain bool
+	// Option is set when the option offered by SetOption was checked before
+	// confirming.
+	Option bool
+}
+
+const (
+	confirmButton = iota
+	cancelButton
+)
+
+// Model asks the user to confirm or cancel something described by its body.
+type Model struct {
+	id           string
+	title        string
+	body         string
+	labels       [2]string
+	keys         KeyMap
+	styles       styles
+	cursor       int
+	width        int
+	height       int
+	askAgain     bool
+	dontAskAgain bool
+	option       string
+	checked      bool
+	active       bool
+}
+
+type dialogOption func(*Model)
+
+// WithLabels names the confirm and cancel buttons.
+func WithLabels(confirm, cancel string) dialogOption {
+	return func(m *Model) {
+		m.labels = [2]string{confirm, cancel}
+	}
+}
+
+// WithDontAskAgain offers a "Don't ask again" checkbox, reported in the
+// ResultMsg once confirmed.
+func WithDontAskAgain() dialogOption {
+	return func(m *Model) {
+		m.askAgain = true
+	}
+}
+
+func (m Model) IsActive() bool {
+	return m.active
+}
+
+// SetBody describes what is about to happen.
+func (m *Model) SetBody(body string) {
+	m.body = body
+}
+
+// SetOption offers a checkbox labelled label until it is set again, an empty
+// label offering none.
+func (m *Model) SetOption(label string) {
+	m.option = label
+	m.keys.OPTION.SetHelp(m.keys.OPTION.Help().Key, strings.ToLower(label))
+}
+
+func (m *Model) Activate() {
+	m.active = true
+	m.cursor = confirmButton
+	m.dontAskAgain = false
+	m.checked = false
+}
+
+func (m *Model) Deactivate() {
+	m.active = false
+}
+
+// Open, Resize and UpdateDialog let the dialog be shown by an overlay.Stack.
+func (m Model) Open() (overlay.Dialog, tea.Cmd) {
+	m.Activate()
+	return m, nil
+}
+
+func (m Model) Resize(h, v int) overlay.Dialog {
+	m.SetSize(h, v)
+	return m
+}
+
+func (m Model) UpdateDialog(msg tea.Msg) (overlay.Dialog, tea.Cmd) {
+	return m.Update(msg)
+}
+
+func (m *Model) SetSize(h, v int) {
+	m.width = h
+	m.height = v
+}
+
+func (m Model) ShortHelp() []key.Binding {
+	return m.keys.ShortHelp()
+}
+
+func (m Model) FullHelp() [][]key.Binding {
+	keys := m.keys.FullHelp()
+	checkboxes := make([]key.Binding, 0, 2)
+	if m.askAgain {
+		checkboxes = append(checkboxes, m.keys.TOGGLE)
+	}
+	if m.option != "" {
+		checkboxes = append(checkboxes, m.keys.OPTION)
+	}
+	if len(checkboxes) == 0 {
+		return keys[:2]
+	}
+	return append(keys[:2], checkboxes)
+}
+
+type KeyMap struct {
+	PREV    key.Binding
+	NEXT    key.Binding
+	SUBMIT  key.Binding
+	CONFIRM key.Binding
+	CANCEL  key.Binding
+	TOGGLE  key.Binding
+	OPTION  key.Binding
+}
+
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{}
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.PREV, k.NEXT, k.SUBMIT}, {k.CONFIRM, k.CANCEL}, {k.TOGGLE, k.OPTION}}
+}
+
+var defaultKeys = KeyMap{
+	PREV:    key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "previous")),
+	NEXT:    key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "next")),
+	SUBMIT:  key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
+	CONFIRM: key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "confirm")),
+	CANCEL:  key.NewBinding(key.WithKeys("esc", "n"), key.WithHelp("esc/n", "cancel")),
+	TOGGLE:  key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "don't ask again")),
+	OPTION:  key.NewBinding(key.WithKeys("o"), key.WithHelp("o", "toggle option")),
+}
+
+func init() {
+	keymap.Register("dialog", &defaultKeys)
+}
+
+func (m *Model) close(confirmed bool) tea.Cmd {
+	result := ResultMsg{Dialog: m.id, Confirmed: confirmed, DontAskAgain: confirmed && m.dontAskAgain, Option: confirmed && m.checked}
+	m.Deactivate()
+	return func() tea.Msg {
+		return result
+	}
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.PREV):
+			m.cursor = confirmButton
+		case key.Matches(msg, m.keys.NEXT):
+			m.cursor = cancelButton
+		case key.Matches(msg, m.keys.SUBMIT):
+			cmd = m.close(m.cursor == confirmButton)
+		case key.Matches(msg, m.keys.CONFIRM):
+			cmd = m.close(true)
+		case key.Matches(msg, m.keys.CANCEL):
+			cmd = m.close(false)
+		case m.askAgain && key.Matches(msg, m.keys.TOGGLE):
+			m.dontAskAgain = !m.dontAskAgain
+		case m.option != "" && key.Matches(msg, m.keys.OPTION):
+			m.checked = !m.checked
+		}
+	}
+	return m, cmd
+}
+
+func checkbox(label string, checked bool) string {
+	if checked {
+		return "[x] " + label
+	}
+	return "[ ] " + label
+}
+
+func (m Model) View() string {
+	innerWidth := m.width - dialogStyle.GetHorizontalFrameSize()
+
+	buttons := make([]string, len(m.labels))
+	for i, label := range m.labels {
+		if i == m.cursor {
+			buttons[i] = m.styles.currentButton.Render(label)
+		} else {
+			buttons[i] = buttonStyle.Render(label)
+		}
+	}
+	footer := make([]string, 0, 3)
+	if m.option != "" {
+		footer = append(footer, m.styles.checkbox.Render(checkbox(m.option, m.checked)))
+	}
+	if m.askAgain {
+		footer = append(footer, m.styles.checkbox.Render(checkbox("Don't ask again", m.dontAskAgain)))
+	}
+	footer = append(footer, lipgloss.PlaceHorizontal(innerWidth, lipgloss.Right, lipgloss.JoinHorizontal(lipgloss.Top, buttons...)))
+
+	// The body gives up its last lines when the dialog doesn't fit.
+	bodyHeight := m.height - dialogStyle.GetVerticalFrameSize() - lipgloss.Height(strings.Join(footer, "\n")) - 1
+	lines := strings.Split(wrap.String(wordwrap.String(m.body, innerWidth), innerWidth), "\n")
+	if bodyHeight > 0 && len(lines) > bodyHeight {
+		lines = append(lines[:bodyHeight-1], "…")
+	}
+
+	body := m.styles.dialog.Render(lipgloss.JoinVertical(lipgloss.Left,
+		append([]string{lipgloss.PlaceHorizontal(innerWidth, lipgloss.Left, strings.Join(lines, "\n")), ""}, footer...)...))
+	return overlay.PlaceTitle(m.title, body, 0, 0, dialogStyle.GetHorizontalFrameSize()/2)
+}
+
+func New(id, title string, options ...dialogOption) Model {
+	m := Model{
+		id:     id,
+		title:  title,
+		labels: [2]string{"Confirm", "Cancel"},
+		keys:   defaultKeys,
+		styles: newStyles(theme.Default),
+	}
+	for _, opt := range options {
+		opt(&m)
+	}
+	return m
+}
@@ -0,0 +1,101 @@
+package dialog
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func press(m Model, keys ...string) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	for _, k := range keys {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		switch k {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case " ":
+			msg = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(k)}
+		}
+		m, cmd = m.Update(msg)
+	}
+	return m, cmd
+}
+
+func result(t *testing.T, cmd tea.Cmd) ResultMsg {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("the dialog didn't close")
+	}
+	msg, ok := cmd().(ResultMsg)
+	if !ok {
+		t.Fatalf("closed with %T", msg)
+	}
+	return msg
+}
+
+func newTestDialog() Model {
+	m := New("confirm", "Generate Project", WithLabels("Generate", "Cancel"), WithDontAskAgain())
+	m.SetSize(60, 20)
+	m.Activate()
+	return m
+}
+
+func TestResult(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []string
+		want ResultMsg
+	}{
+		{"confirm", []string{"y"}, ResultMsg{Dialog: "confirm", Confirmed: true}},
+		{"submit", []string{"enter"}, ResultMsg{Dialog: "confirm", Confirmed: true}},
+		{"submit cancel", []string{"l", "enter"}, ResultMsg{Dialog: "confirm"}},
+		{"cancel", []string{"esc"}, ResultMsg{Dialog: "confirm"}},
+		{"don't ask again", []string{" ", "y"}, ResultMsg{Dialog: "confirm", Confirmed: true, DontAskAgain: true}},
+		{"option", []string{"o", "y"}, ResultMsg{Dialog: "confirm", Confirmed: true, Option: true}},
+		{"option unchecked", []string{"o", "o", "y"}, ResultMsg{Dialog: "confirm", Confirmed: true}},
+		{"checked and cancelled", []string{"o", " ", "n"}, ResultMsg{Dialog: "confirm"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestDialog()
+			m.SetOption("Initialize a git repository")
+			m, cmd := press(m, tt.keys...)
+			if got := result(t, cmd); got != tt.want {
+				t.Errorf("result = %+v, want %+v", got, tt.want)
+			}
+			if m.IsActive() {
+				t.Error("still active once closed")
+			}
+		})
+	}
+}
+
+func TestOption(t *testing.T) {
+	m := newTestDialog()
+	if strings.Contains(m.View(), "Initialize") || len(m.FullHelp()) != 3 {
+		t.Error("offers an option before one is set")
+	}
+	// Without an option its key does nothing.
+	if _, cmd := press(m, "o", "y"); result(t, cmd).Option {
+		t.Error("the option was checked without being offered")
+	}
+
+	m.SetOption("Initialize a git repository")
+	m, _ = press(m, "o")
+	if view := m.View(); !strings.Contains(view, "[x] Initialize a git repository") || !strings.Contains(view, "[ ] Don't ask again") {
+		t.Errorf("view lacks the checkboxes:\n%s", view)
+	}
+	help := m.FullHelp()
+	if got := help[len(help)-1][1].Help().Desc; got != "initialize a git repository" {
+		t.Errorf("help of the option = %q", got)
+	}
+
+	// Opening the dialog again unchecks it.
+	m.Activate()
+	if !strings.Contains(m.View(), "[ ] Initialize a git repository") {
+		t.Error("the option stayed checked")
+	}
+}
@@ -0,0 +1,229 @@
+package diffView
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/eslam-allam/spring-initializer-go/models/keymap"
+	"github.com/eslam-allam/spring-initializer-go/models/overlay"
+	"github.com/eslam-allam/spring-initializer-go/models/picker"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
+	"github.com/eslam-allam/spring-initializer-go/service/upgrade"
+)
+
+const bootVersionPickerId = "boot-version"
+
+var (
+	docStyle   lipgloss.Style = lipgloss.NewStyle().Border(lipgloss.ThickBorder(), true).Padding(0, 1)
+	titleStyle lipgloss.Style = lipgloss.NewStyle().Bold(true)
+	fileStyle  lipgloss.Style = lipgloss.NewStyle().Bold(true)
+)
+
+type styles struct {
+	added   lipgloss.Style
+	removed lipgloss.Style
+	hunk    lipgloss.Style
+}
+
+func newStyles(t theme.Theme) styles {
+	return styles{
+		added:   lipgloss.NewStyle().Foreground(lipgloss.Color(t.Success)),
+		removed: lipgloss.NewStyle().Foreground(lipgloss.Color(t.Failure)),
+		hunk:    t.Accent(),
+	}
+}
+
+// SetTheme restyles the diff and the version picker using the colours of
+// the given theme.
+func (m *Model) SetTheme(t theme.Theme) {
+	m.styles = newStyles(t)
+	m.help.Styles = t.Help()
+	m.versions.SetTheme(t)
+}
+
+type diffLoaded struct {
+	bootVersion string
+	diff        string
+	err         error
+}
+
+type Model struct {
+	upgrade     upgrade.Upgrade
+	bootVersion string
+	keys        KeyMap
+	styles      styles
+	help        help.Model
+	viewport    viewport.Model
+	spinner     spinner.Model
+	versions    picker.Model
+	dialogs     overlay.Stack
+	err         error
+	loading     bool
+	width       int
+	height      int
+}
+
+type KeyMap struct {
+	viewport.KeyMap
+	VERSION key.Binding
+	QUIT    key.Binding
+}
+
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.VERSION, k.QUIT}
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Up, k.Down}, {k.PageUp, k.PageDown}, {k.HalfPageUp, k.HalfPageDown}, {k.VERSION, k.QUIT}}
+}
+
+var defaultKeys = KeyMap{
+	KeyMap:  viewport.DefaultKeyMap(),
+	VERSION: key.NewBinding(key.WithKeys("v"), key.WithHelp("v", "boot version")),
+	QUIT:    key.NewBinding(key.WithKeys("q", "ctrl+q"), key.WithHelp("q", "quit")),
+}
+
+func init() {
+	keymap.Register("diff", &defaultKeys)
+}
+
+func (m Model) load() tea.Cmd {
+	u, bootVersion := m.upgrade, m.bootVersion
+	return func() tea.Msg {
+		diff, err := u.Diff(bootVersion)
+		return diffLoaded{bootVersion: bootVersion, diff: diff, err: err}
+	}
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.load())
+}
+
+// colorize styles the lines of a unified diff.
+func (m Model) colorize(diff string) string {
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"):
+			lines[i] = fileStyle.Render(line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = m.styles.hunk.Render(line)
+		case strings.HasPrefix(line, "+"):
+			lines[i] = m.styles.added.Render(line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = m.styles.removed.Render(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		h, v := docStyle.GetFrameSize()
+		m.viewport.Width = m.width - h
+		m.viewport.Height = m.height - v - 2
+		m.help.Width = m.width - h
+		m.dialogs.SetSize(m.viewport.Width/2, m.viewport.Height/2)
+
+	case spinner.TickMsg:
+		if m.loading {
+			m.spinner, cmd = m.spinner.Update(msg)
+		}
+
+	case diffLoaded:
+		if msg.bootVersion != m.bootVersion {
+			break
+		}
+		m.loading = false
+		m.err = msg.err
+		content := m.colorize(msg.diff)
+		if msg.diff == "" {
+			content = "Build and wrapper files are up to date."
+		}
+		if len(m.upgrade.Unmapped) > 0 {
+			content = fmt.Sprintf("Unmapped artifacts (kept out of the comparison): %s\n\n%s",
+				strings.Join(m.upgrade.Unmapped, ", "), content)
+		}
+		m.viewport.SetContent(content)
+		m.viewport.GotoTop()
+
+	case picker.PickedMsg:
+		if msg.Picker == bootVersionPickerId && msg.Item.Id != m.bootVersion {
+			m.bootVersion = msg.Item.Id
+			m.loading = true
+			cmd = tea.Batch(m.spinner.Tick, m.load())
+		}
+
+	case overlay.PushMsg:
+		m.dialogs, cmd = m.dialogs.Update(msg)
+
+	case tea.KeyMsg:
+		if m.dialogs.IsActive() {
+			m.dialogs, cmd = m.dialogs.Update(msg)
+			return m, cmd
+		}
+		switch {
+		case key.Matches(msg, m.keys.QUIT):
+			return m, tea.Quit
+		case key.Matches(msg, m.keys.VERSION):
+			return m, overlay.Push(m.versions)
+		}
+		m.viewport, cmd = m.viewport.Update(msg)
+
+	default:
+		// Such as the blinking of the cursor of the version picker's filter.
+		m.dialogs, cmd = m.dialogs.Update(msg)
+	}
+	return m, cmd
+}
+
+func (m Model) View() string {
+	title := titleStyle.Render(fmt.Sprintf("Upgrade %s → %s", m.upgrade.CurrentBootVersion(), m.bootVersion))
+
+	var body string
+	switch {
+	case m.loading:
+		body = lipgloss.Place(m.viewport.Width, m.viewport.Height, lipgloss.Center, lipgloss.Center,
+			lipgloss.JoinHorizontal(lipgloss.Center, m.spinner.View(), "Generating project..."))
+	case m.err != nil:
+		body = lipgloss.Place(m.viewport.Width, m.viewport.Height, lipgloss.Center, lipgloss.Center,
+			m.styles.removed.Render(fmt.Sprintf("Failed to generate project: %s", m.err)))
+	default:
+		body = m.viewport.View()
+	}
+
+	view := docStyle.Render(lipgloss.JoinVertical(lipgloss.Left, body, "", m.help.View(m.keys)))
+	view = overlay.PlaceTitle(title, view, 0, 0, 1)
+	return m.dialogs.Place(view)
+}
+
+// New creates a scrollable view of the changes regenerating the project of u
+// with the given boot version would bring.
+func New(u upgrade.Upgrade, bootVersion string) Model {
+	items := make([]picker.Item, 0)
+	for _, v := range u.BootVersions() {
+		items = append(items, picker.Item{Id: v.Id, Name: v.Name})
+	}
+	vp := viewport.New(0, 0)
+	vp.KeyMap = defaultKeys.KeyMap
+	return Model{
+		upgrade:     u,
+		bootVersion: bootVersion,
+		keys:        defaultKeys,
+		styles:      newStyles(theme.Default),
+		help:        help.New(),
+		viewport:    vp,
+		spinner:     spinner.New(spinner.WithSpinner(spinner.Dot)),
+		versions:    picker.New(bootVersionPickerId, "Boot Version", items...),
+		loading:     true,
+	}
+}
@@ -0,0 +1,249 @@
+package hookLog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/eslam-allam/spring-initializer-go/models/keymap"
+	"github.com/eslam-allam/spring-initializer-go/models/overlay"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
+	"github.com/eslam-allam/spring-initializer-go/service/hooks"
+	"github.com/muesli/reflow/truncate"
+	"github.com/muesli/reflow/wrap"
+)
+
+var logStyle lipgloss.Style = lipgloss.NewStyle().Padding(1).Border(lipgloss.NormalBorder(), true)
+
+type styles struct {
+	log     lipgloss.Style
+	running lipgloss.Style
+	success lipgloss.Style
+	failure lipgloss.Style
+	pending lipgloss.Style
+	step    lipgloss.Style
+}
+
+func newStyles(t theme.Theme) styles {
+	return styles{
+		log:     t.Focused(logStyle),
+		running: t.Accent(),
+		success: lipgloss.NewStyle().Foreground(lipgloss.Color(t.Success)),
+		failure: lipgloss.NewStyle().Foreground(lipgloss.Color(t.Failure)),
+		pending: t.Muted(),
+		step:    t.Accent().Bold(true),
+	}
+}
+
+// SetTheme restyles the log using the colours of the given theme.
+func (m *Model) SetTheme(t theme.Theme) {
+	m.styles = newStyles(t)
+	m.render()
+}
+
+type stepState int
+
+const (
+	PENDING stepState = iota
+	RUNNING
+	SUCCEEDED
+	FAILED
+)
+
+type step struct {
+	title string
+	state stepState
+	err   error
+}
+
+type line struct {
+	step int
+	text string
+}
+
+// Model shows the output of the post-generation hooks as they run, along
+// with the state of every step.
+type Model struct {
+	keys     KeyMap
+	styles   styles
+	steps    []step
+	lines    []line
+	viewport viewport.Model
+	width    int
+	height   int
+	active   bool
+}
+
+func (m Model) IsActive() bool {
+	return m.active
+}
+
+// Open, Resize and UpdateDialog let the log be shown by an overlay.Stack.
+func (m Model) Open() (overlay.Dialog, tea.Cmd) {
+	m.active = true
+	return m, nil
+}
+
+func (m Model) Resize(h, v int) overlay.Dialog {
+	m.SetSize(h, v)
+	return m
+}
+
+func (m Model) UpdateDialog(msg tea.Msg) (overlay.Dialog, tea.Cmd) {
+	return m.Update(msg)
+}
+
+func (m *Model) SetSize(h, v int) {
+	m.width = h
+	m.height = v
+	m.viewport.Width = max(h-logStyle.GetHorizontalFrameSize(), 1)
+	m.viewport.Height = max(v-logStyle.GetVerticalFrameSize()-len(m.steps)-1, 1)
+	m.render()
+}
+
+// Finished reports whether every step is done.
+func (m Model) Finished() bool {
+	for _, s := range m.steps {
+		if s.state == PENDING || s.state == RUNNING {
+			return false
+		}
+	}
+	return true
+}
+
+func (m Model) ShortHelp() []key.Binding {
+	return m.keys.ShortHelp()
+}
+
+func (m Model) FullHelp() [][]key.Binding {
+	return m.keys.FullHelp()
+}
+
+type KeyMap struct {
+	viewport.KeyMap
+	CLOSE key.Binding
+}
+
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{}
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Up, k.Down}, {k.PageUp, k.PageDown}, {k.CLOSE}}
+}
+
+var defaultKeys = KeyMap{
+	KeyMap: viewport.DefaultKeyMap(),
+	CLOSE:  key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "close log")),
+}
+
+func init() {
+	keymap.Register("hook-log", &defaultKeys)
+}
+
+// render lays out the output of the hooks, following it as it comes unless
+// scrolled up.
+func (m *Model) render() {
+	follow := m.viewport.AtBottom()
+	contents := make([]string, 0, len(m.lines))
+	current := -1
+	for _, l := range m.lines {
+		if l.step != current {
+			current = l.step
+			contents = append(contents, m.styles.step.Render("$ "+m.steps[l.step].title))
+		}
+		contents = append(contents, wrap.String(l.text, m.viewport.Width))
+	}
+	m.viewport.SetContent(strings.Join(contents, "\n"))
+	if follow {
+		m.viewport.GotoBottom()
+	}
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg := msg.(type) {
+	case hooks.Event:
+		if msg.Step >= len(m.steps) {
+			break
+		}
+		switch {
+		case msg.Done && msg.Err != nil:
+			m.steps[msg.Step].state = FAILED
+			m.steps[msg.Step].err = msg.Err
+			m.lines = append(m.lines, line{step: msg.Step, text: m.styles.failure.Render(msg.Err.Error())})
+		case msg.Done:
+			m.steps[msg.Step].state = SUCCEEDED
+		default:
+			m.steps[msg.Step].state = RUNNING
+			m.lines = append(m.lines, line{step: msg.Step, text: msg.Line})
+		}
+		if msg.Done && msg.Step+1 < len(m.steps) {
+			m.steps[msg.Step+1].state = RUNNING
+		}
+		m.render()
+
+	case tea.KeyMsg:
+		if key.Matches(msg, m.keys.CLOSE) {
+			m.active = false
+			return m, nil
+		}
+		m.viewport, cmd = m.viewport.Update(msg)
+
+	case tea.MouseMsg:
+		m.viewport, cmd = m.viewport.Update(msg)
+	}
+	return m, cmd
+}
+
+func (m Model) stepView(s step) string {
+	switch s.state {
+	case RUNNING:
+		return m.styles.running.Render("● " + s.title)
+	case SUCCEEDED:
+		return m.styles.success.Render("✓ " + s.title)
+	case FAILED:
+		return m.styles.failure.Render(fmt.Sprintf("✗ %s (%v)", s.title, s.err))
+	default:
+		return m.styles.pending.Render("○ " + s.title)
+	}
+}
+
+func (m Model) View() string {
+	innerWidth := m.width - logStyle.GetHorizontalFrameSize()
+	steps := make([]string, len(m.steps))
+	for i, s := range m.steps {
+		steps[i] = truncate.StringWithTail(m.stepView(s), uint(max(innerWidth, 0)), "…")
+	}
+
+	title := "Hooks"
+	if m.Finished() {
+		title = "Hooks (done)"
+	}
+	body := m.styles.log.Render(lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.PlaceHorizontal(innerWidth, lipgloss.Left, strings.Join(steps, "\n")), "", m.viewport.View()))
+	return overlay.PlaceTitle(title, body, 0, 0, logStyle.GetHorizontalFrameSize()/2)
+}
+
+// New creates a log for the given hooks, the first of which is about to
+// run.
+func New(hs ...hooks.Hook) Model {
+	steps := make([]step, len(hs))
+	for i, h := range hs {
+		steps[i] = step{title: h.Title()}
+	}
+	if len(steps) > 0 {
+		steps[0].state = RUNNING
+	}
+	vp := viewport.New(0, 0)
+	vp.KeyMap = defaultKeys.KeyMap
+	return Model{
+		keys:     defaultKeys,
+		styles:   newStyles(theme.Default),
+		steps:    steps,
+		viewport: vp,
+	}
+}
@@ -0,0 +1,83 @@
+package hookLog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/eslam-allam/spring-initializer-go/service/hooks"
+)
+
+func states(m Model) []stepState {
+	s := make([]stepState, len(m.steps))
+	for i, step := range m.steps {
+		s[i] = step.state
+	}
+	return s
+}
+
+func TestEvents(t *testing.T) {
+	m := New(hooks.Hook{Run: "mvn verify"}, hooks.Hook{Name: "format", Run: "mvn spotless:apply"}, hooks.Hook{Git: &hooks.Git{}})
+	m.SetSize(60, 20)
+
+	events := []hooks.Event{
+		{Step: 0, Line: "BUILD SUCCESS"},
+		{Step: 0, Done: true},
+		{Step: 1, Done: true, Err: errors.New("exit status 1")},
+	}
+	for _, e := range events {
+		m, _ = m.Update(e)
+	}
+	if got, want := fmt.Sprint(states(m)), fmt.Sprint([]stepState{SUCCEEDED, FAILED, RUNNING}); got != want {
+		t.Errorf("states = %s, want %s", got, want)
+	}
+	if m.Finished() {
+		t.Error("Finished() with git init still running")
+	}
+
+	view := m.View()
+	for _, want := range []string{"✓ mvn verify", "✗ format (exit status 1)", "● git init", "$ mvn verify", "BUILD SUCCESS"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view lacks %q:\n%s", want, view)
+		}
+	}
+
+	m, _ = m.Update(hooks.Event{Step: 2, Done: true})
+	// Events of unknown steps are dropped.
+	m, _ = m.Update(hooks.Event{Step: 3, Done: true})
+	if !m.Finished() {
+		t.Error("not Finished() once every step is done")
+	}
+	if !strings.Contains(m.View(), "Hooks (done)") {
+		t.Error("the title doesn't tell the hooks are done")
+	}
+}
+
+func TestScrollAndClose(t *testing.T) {
+	m := New(hooks.Hook{Run: "mvn verify"})
+	m.SetSize(60, 10)
+	d, _ := m.Open()
+	m = d.(Model)
+	for i := 0; i < 20; i++ {
+		m, _ = m.Update(hooks.Event{Step: 0, Line: fmt.Sprintf("line %d", i)})
+	}
+	if !m.viewport.AtBottom() {
+		t.Fatal("the log doesn't follow the output")
+	}
+
+	m, _ = m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelUp})
+	if m.viewport.AtBottom() {
+		t.Error("the wheel didn't scroll the log up")
+	}
+	m, _ = m.Update(hooks.Event{Step: 0, Line: "more"})
+	if m.viewport.AtBottom() {
+		t.Error("the log followed the output once scrolled up")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.IsActive() {
+		t.Error("esc didn't close the log")
+	}
+}
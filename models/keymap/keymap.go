@@ -0,0 +1,166 @@
+// Package keymap lets the user rebind the keys of every model from the keys
+// section of config.yaml:
+//
+//	keys:
+//	  main:
+//	    quit: [ctrl+x]
+//	  list:
+//	    next: [down, j, n]
+//
+// Each model registers its default key map under a context name and the
+// actions are the key map's fields in kebab-case.
+package keymap
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// Overrides maps a context to the keys of each of its actions.
+type Overrides map[string]map[string][]string
+
+type context struct {
+	name    string
+	keyMap  any
+	shadows []string
+}
+
+var contexts []context
+
+// Register makes the bindings of keyMap, a pointer to a struct of
+// key.Binding fields, configurable under the given context name. The
+// bindings of keyMap are handled before those of the shadowed contexts, so a
+// key bound in both is reported as a conflict.
+func Register(name string, keyMap any, shadows ...string) {
+	contexts = append(contexts, context{name: name, keyMap: keyMap, shadows: shadows})
+}
+
+func find(name string) (context, bool) {
+	for _, c := range contexts {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return context{}, false
+}
+
+// actionName turns a key map field such as NEXT_SECTION or ToggleSelect into
+// next-section or toggle-select.
+func actionName(field string) string {
+	var b strings.Builder
+	var prev rune
+	for _, r := range field {
+		switch {
+		case r == '_':
+			b.WriteRune('-')
+		case unicode.IsUpper(r) && unicode.IsLower(prev):
+			b.WriteRune('-')
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(unicode.ToLower(r))
+		}
+		prev = r
+	}
+	return b.String()
+}
+
+// bindings returns the bindings of a key map by action, including those of
+// embedded key maps.
+func bindings(v reflect.Value) map[string]*key.Binding {
+	found := make(map[string]*key.Binding)
+	bindingType := reflect.TypeOf(key.Binding{})
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		switch {
+		case field.Type == bindingType:
+			found[actionName(field.Name)] = v.Field(i).Addr().Interface().(*key.Binding)
+		case field.Anonymous && field.Type.Kind() == reflect.Struct:
+			for action, binding := range bindings(v.Field(i)) {
+				found[action] = binding
+			}
+		}
+	}
+	return found
+}
+
+func (c context) bindings() map[string]*key.Binding {
+	return bindings(reflect.ValueOf(c.keyMap).Elem())
+}
+
+// Apply rebinds the actions listed in overrides. The help shown for a
+// rebound action lists its new keys, and an action bound to no keys is
+// disabled. Unknown contexts and actions are skipped and reported in the
+// returned error.
+func Apply(overrides Overrides) error {
+	problems := make([]string, 0)
+	for name, actions := range overrides {
+		c, ok := find(name)
+		if !ok {
+			problems = append(problems, fmt.Sprintf("unknown context %q", name))
+			continue
+		}
+		available := c.bindings()
+		for action, keys := range actions {
+			binding, ok := available[action]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("unknown action %q in %q", action, name))
+				continue
+			}
+			binding.SetKeys(keys...)
+			binding.SetHelp(strings.Join(keys, "/"), binding.Help().Desc)
+			binding.SetEnabled(len(keys) > 0)
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("invalid key bindings: %s", strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// Conflicts lists the keys bound to more than one action of a context, or
+// to actions of both a context and one it shadows.
+func Conflicts() []string {
+	conflicts := make([]string, 0)
+	for _, c := range contexts {
+		owners := make(map[string][]string)
+		for action, binding := range c.bindings() {
+			if !binding.Enabled() {
+				continue
+			}
+			for _, k := range binding.Keys() {
+				owners[k] = append(owners[k], action)
+			}
+		}
+		for k, actions := range owners {
+			if len(actions) > 1 {
+				sort.Strings(actions)
+				conflicts = append(conflicts, fmt.Sprintf("%q is bound to %s in %s", k, strings.Join(actions, " and "), c.name))
+			}
+		}
+
+		for _, name := range c.shadows {
+			shadowed, ok := find(name)
+			if !ok {
+				continue
+			}
+			for action, binding := range shadowed.bindings() {
+				if !binding.Enabled() {
+					continue
+				}
+				for _, k := range binding.Keys() {
+					for _, owner := range owners[k] {
+						conflicts = append(conflicts, fmt.Sprintf("%q of %s.%s is taken by %s.%s", k, name, action, c.name, owner))
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(conflicts)
+	return conflicts
+}
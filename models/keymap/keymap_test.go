@@ -0,0 +1,117 @@
+package keymap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type listKeys struct {
+	UP   key.Binding
+	DOWN key.Binding
+}
+
+type mainKeys struct {
+	listKeys
+	QUIT        key.Binding
+	NextSection key.Binding
+}
+
+// useContexts registers the given contexts in place of the app's ones for
+// the duration of the test.
+func useContexts(t *testing.T, register func()) {
+	t.Helper()
+	saved := contexts
+	contexts = nil
+	t.Cleanup(func() { contexts = saved })
+	register()
+}
+
+func newMainKeys() *mainKeys {
+	return &mainKeys{
+		listKeys: listKeys{
+			UP:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+			DOWN: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+		},
+		QUIT:        key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "quit")),
+		NextSection: key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next section")),
+	}
+}
+
+func TestActionName(t *testing.T) {
+	tests := map[string]string{
+		"QUIT":         "quit",
+		"NEXT_SECTION": "next-section",
+		"ToggleSelect": "toggle-select",
+		"PageDown":     "page-down",
+	}
+	for field, want := range tests {
+		if got := actionName(field); got != want {
+			t.Errorf("actionName(%q) = %q, want %q", field, got, want)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	keys := newMainKeys()
+	useContexts(t, func() { Register("main", keys) })
+
+	err := Apply(Overrides{"main": {
+		"quit":         {"ctrl+x", "q"},
+		"next-section": {},
+		"down":         {"n"},
+	}})
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if !reflect.DeepEqual(keys.QUIT.Keys(), []string{"ctrl+x", "q"}) {
+		t.Errorf("quit keys = %v", keys.QUIT.Keys())
+	}
+	if help := keys.QUIT.Help(); help.Key != "ctrl+x/q" || help.Desc != "quit" {
+		t.Errorf("quit help = %+v", help)
+	}
+	if keys.NextSection.Enabled() {
+		t.Error("next-section bound to no keys should be disabled")
+	}
+	if !reflect.DeepEqual(keys.DOWN.Keys(), []string{"n"}) {
+		t.Errorf("embedded down keys = %v", keys.DOWN.Keys())
+	}
+}
+
+func TestApplyUnknown(t *testing.T) {
+	useContexts(t, func() { Register("main", newMainKeys()) })
+	err := Apply(Overrides{"main": {"fly": {"f"}}, "nope": {"quit": {"q"}}})
+	if err == nil {
+		t.Fatal("expected an error for unknown contexts and actions")
+	}
+	for _, problem := range []string{`unknown action "fly" in "main"`, `unknown context "nope"`} {
+		if !strings.Contains(err.Error(), problem) {
+			t.Errorf("error %q doesn't report %s", err, problem)
+		}
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	main, list := newMainKeys(), &listKeys{
+		UP:   key.NewBinding(key.WithKeys("up")),
+		DOWN: key.NewBinding(key.WithKeys("down")),
+	}
+	useContexts(t, func() {
+		Register("main", main, "list")
+		Register("list", list)
+	})
+	if got := Conflicts(); len(got) != 2 {
+		// The embedded up and down of main take the keys of the list.
+		t.Fatalf("got %v, want the shadowed up and down", got)
+	}
+
+	if err := Apply(Overrides{"main": {"up": {"w"}, "down": {"s"}, "quit": {"tab"}}}); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	want := []string{`"tab" is bound to next-section and quit in main`}
+	if got := Conflicts(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,75 @@
+package mainModel
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/eslam-allam/spring-initializer-go/models/buttons"
+	"github.com/eslam-allam/spring-initializer-go/models/dialog"
+	"github.com/eslam-allam/spring-initializer-go/models/notification"
+	"github.com/eslam-allam/spring-initializer-go/service/config"
+	"github.com/eslam-allam/spring-initializer-go/service/hooks"
+	"github.com/eslam-allam/spring-initializer-go/service/springio"
+)
+
+// confirmationBody sums up the project action is about to generate.
+func (m model) confirmationBody(action buttons.Action) string {
+	c := m.currentConfig()
+	archive := springio.ArchiveName(m.project.GetSelected().Action)
+	if action == buttons.DOWNLOAD_EXTRACT {
+		archive += " (extracted)"
+	}
+	dependencies := strings.Join(m.dependencies.SelectedNames(), ", ")
+	if dependencies == "" {
+		dependencies = "none"
+	}
+
+	entries := [][2]string{
+		{"Target", m.targetDirectory},
+		{"Archive", archive},
+		{"Spring Boot", m.springBootVersion.GetSelected().Name},
+		{"Java", m.javaVersion.GetSelected().Name},
+		{"Coordinates", fmt.Sprintf("%s:%s", c.Metadata["groupId"], c.Metadata["artifactId"])},
+		{"Dependencies", dependencies},
+	}
+	labelWidth := 0
+	for _, entry := range entries {
+		labelWidth = max(labelWidth, lipgloss.Width(entry[0]))
+	}
+	lines := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		lines = append(lines, fmt.Sprintf("%-*s  %s", labelWidth, entry[0], entry[1]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// confirmationOption offers to make the project a git repository once
+// extracted, unless the hooks already do.
+func (m model) confirmationOption(action buttons.Action) string {
+	if action != buttons.DOWNLOAD_EXTRACT || hooks.HasGit(m.hooks) {
+		return ""
+	}
+	return "Initialize a git repository"
+}
+
+// confirmed generates the pending project once confirmed, remembering not to
+// ask again when requested.
+func (m *model) confirmed(msg dialog.ResultMsg) tea.Cmd {
+	if !msg.Confirmed {
+		m.buttons, _ = m.buttons.Update(buttons.ActionStateMessage{State: buttons.ACTION_RESET})
+		return nil
+	}
+	cmd := m.runAction(m.pendingAction, msg.Option)
+	if !msg.DontAskAgain {
+		return cmd
+	}
+
+	m.confirm = false
+	if err := config.SaveSetting("skipConfirmation", true); err != nil {
+		logger.Printf("Error saving settings: %v", err)
+		return tea.Batch(cmd, notify(fmt.Sprintf("Failed to save settings: %s", err), notification.ERROR))
+	}
+	return cmd
+}
@@ -0,0 +1,87 @@
+package mainModel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eslam-allam/spring-initializer-go/models/buttons"
+	"github.com/eslam-allam/spring-initializer-go/models/dependency"
+	"github.com/eslam-allam/spring-initializer-go/models/metadata"
+	"github.com/eslam-allam/spring-initializer-go/models/radioList"
+	"github.com/eslam-allam/spring-initializer-go/service/hooks"
+)
+
+func TestConfirmationBody(t *testing.T) {
+	m := model{
+		targetDirectory:   "/tmp/projects",
+		project:           radioList.New(radioList.VERTICAL, radioList.Item{Name: "Maven", Id: "maven-project", Action: "/starter.zip"}),
+		springBootVersion: radioList.New(radioList.VERTICAL, radioList.Item{Name: "3.2.0", Id: "3.2.0"}),
+		javaVersion:       radioList.New(radioList.VERTICAL, radioList.Item{Name: "21", Id: "21"}),
+		language:          radioList.New(radioList.VERTICAL, radioList.Item{Name: "Java", Id: "java"}),
+		packaging:         radioList.New(radioList.HORIZONTAL, radioList.Item{Name: "Jar", Id: "jar"}),
+		metadata: metadata.New(
+			metadata.NewField("Group", "groupId", "com.example"),
+			metadata.NewField("Artifact", "artifactId", "demo"),
+		),
+		dependencies: dependency.New(
+			dependency.Dependency{Id: "web", Name: "Spring Web"},
+			dependency.Dependency{Id: "security", Name: "Spring Security"},
+		),
+	}
+	m.dependencies.SetSelected([]string{"web"})
+
+	want := strings.Join([]string{
+		"Target        /tmp/projects",
+		"Archive       starter.zip (extracted)",
+		"Spring Boot   3.2.0",
+		"Java          21",
+		"Coordinates   com.example:demo",
+		"Dependencies  Spring Web",
+	}, "\n")
+	if got := m.confirmationBody(buttons.DOWNLOAD_EXTRACT); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestConfirmationOffersGit(t *testing.T) {
+	m := newReadyModel(t, WithHooks(hooks.Hook{Run: "./mvnw -q verify"}))
+	for _, tt := range []struct {
+		action buttons.Action
+		want   string
+	}{
+		{buttons.DOWNLOAD, ""},
+		{buttons.DOWNLOAD_EXTRACT, "Initialize a git repository"},
+	} {
+		next, _ := m.Update(tt.action)
+		m = next.(model)
+		if got := m.confirmationOption(tt.action); got != tt.want {
+			t.Errorf("option of %v = %q, want %q", tt.action, got, tt.want)
+		}
+		if got := strings.Contains(m.confirmation.View(), "Initialize a git repository"); got != (tt.want != "") {
+			t.Errorf("confirmation of %v offers git: %v", tt.action, got)
+		}
+	}
+
+	m.hooks = append(m.hooks, hooks.Hook{Git: &hooks.Git{Branch: "main"}})
+	if got := m.confirmationOption(buttons.DOWNLOAD_EXTRACT); got != "" {
+		t.Errorf("option = %q with a git hook configured", got)
+	}
+}
+
+func TestRunHooksWithGit(t *testing.T) {
+	m := newReadyModel(t, WithHooks(hooks.Hook{Name: "noop", Run: "true"}))
+	if cmd := m.runHooks(t.TempDir(), false); cmd == nil || len(m.running) != 1 {
+		t.Errorf("running %+v, want the configured hook alone", m.running)
+	}
+	if cmd := m.runHooks(t.TempDir(), true); cmd == nil || len(m.running) != 2 || m.running[1].Git == nil {
+		t.Errorf("running %+v, want the configured hook and git", m.running)
+	}
+	if len(m.hooks) != 1 {
+		t.Errorf("the configured hooks changed: %+v", m.hooks)
+	}
+
+	m = newReadyModel(t)
+	if cmd := m.runHooks(t.TempDir(), false); cmd != nil {
+		t.Error("runs hooks without any configured")
+	}
+}
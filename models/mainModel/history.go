@@ -0,0 +1,153 @@
+package mainModel
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/eslam-allam/spring-initializer-go/models/notification"
+	"github.com/eslam-allam/spring-initializer-go/service/springio"
+)
+
+// maxHistory caps the number of changes that can be undone.
+const maxHistory = 100
+
+// change is a snapshot of the selections along with a description of what
+// changed since.
+type change struct {
+	config      springio.ProjectConfig
+	description string
+}
+
+// history records the changes made to the selections of every section so
+// that they can be undone and redone.
+type history struct {
+	past    []change
+	future  []change
+	current springio.ProjectConfig
+}
+
+func (h *history) reset(c springio.ProjectConfig) {
+	h.past = nil
+	h.future = nil
+	h.current = c
+}
+
+// record remembers the current selections before they are replaced by c.
+func (h *history) record(c springio.ProjectConfig, description string) {
+	h.past = append(h.past, change{config: h.current, description: description})
+	if len(h.past) > maxHistory {
+		h.past = h.past[1:]
+	}
+	h.future = nil
+	h.current = c
+}
+
+func (h *history) undo() (change, bool) {
+	if len(h.past) == 0 {
+		return change{}, false
+	}
+	c := h.past[len(h.past)-1]
+	h.past = h.past[:len(h.past)-1]
+	h.future = append(h.future, change{config: h.current, description: c.description})
+	h.current = c.config
+	return c, true
+}
+
+func (h *history) redo() (change, bool) {
+	if len(h.future) == 0 {
+		return change{}, false
+	}
+	c := h.future[len(h.future)-1]
+	h.future = h.future[:len(h.future)-1]
+	h.past = append(h.past, change{config: h.current, description: c.description})
+	h.current = c.config
+	return c, true
+}
+
+// recordChange adds the selections to the history when they differ from the
+// last ones recorded. Metadata is only recorded once a field is no longer
+// being edited, that is once it is submitted or the metadata loses the focus,
+// so that a whole edit is undone at once.
+func (m *model) recordChange() {
+	if m.metadata.IsTyping() {
+		return
+	}
+	c := m.currentConfig()
+	if reflect.DeepEqual(c, m.history.current) {
+		return
+	}
+	m.history.record(c, m.describeChange(m.history.current, c))
+}
+
+func (m *model) undo() tea.Cmd {
+	c, ok := m.history.undo()
+	if !ok {
+		return notify("Nothing to undo.", notification.INFO)
+	}
+	m.applyConfig(c.config)
+	return notify(fmt.Sprintf("Undone: %s.", c.description), notification.INFO)
+}
+
+func (m *model) redo() tea.Cmd {
+	c, ok := m.history.redo()
+	if !ok {
+		return notify("Nothing to redo.", notification.INFO)
+	}
+	m.applyConfig(c.config)
+	return notify(fmt.Sprintf("Redone: %s.", c.description), notification.INFO)
+}
+
+// describeChange sums up the differences between two snapshots, such as
+// "Spring Boot 3.2.0 → 3.3.0" or "added Spring Web".
+func (m model) describeChange(before, after springio.ProjectConfig) string {
+	changes := make([]string, 0)
+	for _, s := range []struct {
+		sec           section
+		before, after string
+	}{
+		{PROJECT, before.Type, after.Type},
+		{LANGUAGE, before.Language, after.Language},
+		{PACKAGING, before.Packaging, after.Packaging},
+		{JAVA, before.JavaVersion, after.JavaVersion},
+		{SPRING_BOOT, before.BootVersion, after.BootVersion},
+	} {
+		if s.before != s.after {
+			changes = append(changes, fmt.Sprintf("%s %s → %s", sectionTitles[s.sec], s.before, s.after))
+		}
+	}
+
+	for _, value := range m.metadata.GetValues() {
+		if before.Metadata[value.Id] != after.Metadata[value.Id] {
+			changes = append(changes, fmt.Sprintf("%s %q → %q", value.Name, before.Metadata[value.Id], after.Metadata[value.Id]))
+		}
+	}
+
+	added, removed := make([]string, 0), make([]string, 0)
+	for _, dep := range m.dependencies.Dependencies() {
+		was, is := slices.Contains(before.Dependencies, dep.Id), slices.Contains(after.Dependencies, dep.Id)
+		switch {
+		case is && !was:
+			added = append(added, dep.Name)
+		case was && !is:
+			removed = append(removed, dep.Name)
+		}
+	}
+	if len(added) > 0 {
+		changes = append(changes, "added "+strings.Join(added, ", "))
+	}
+	if len(removed) > 0 {
+		changes = append(changes, "removed "+strings.Join(removed, ", "))
+	}
+
+	switch len(changes) {
+	case 0:
+		return "selections changed"
+	case 1, 2:
+		return strings.Join(changes, "; ")
+	default:
+		return fmt.Sprintf("%d changes", len(changes))
+	}
+}
@@ -0,0 +1,66 @@
+package mainModel
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUndoRedo(t *testing.T) {
+	m := newReadyModel(t)
+	initial := m.currentConfig()
+
+	// Select Spring Web, then Spring Boot 3.2.0.
+	m = send(m, keyPresses("alt+7", "down", "enter", "alt+5", "down", "enter")...)
+	changed := m.currentConfig()
+	if !reflect.DeepEqual(changed.Dependencies, []string{"web"}) || changed.BootVersion != "3.2.0" {
+		t.Fatalf("selections = %+v", changed)
+	}
+	if len(m.history.past) != 2 {
+		t.Fatalf("recorded %d changes, want 2", len(m.history.past))
+	}
+
+	m = send(m, keyPress("ctrl+z"))
+	if got := m.currentConfig(); got.BootVersion != "3.3.0" || !reflect.DeepEqual(got.Dependencies, []string{"web"}) {
+		t.Errorf("after one undo = %+v", got)
+	}
+	m = send(m, keyPress("ctrl+z"))
+	if got := m.currentConfig(); !reflect.DeepEqual(got, initial) {
+		t.Errorf("after two undos = %+v, want %+v", got, initial)
+	}
+	m = send(m, keyPresses("ctrl+y", "ctrl+y")...)
+	if got := m.currentConfig(); !reflect.DeepEqual(got, changed) {
+		t.Errorf("after two redos = %+v, want %+v", got, changed)
+	}
+
+	// A new change drops what could be redone.
+	m = send(m, keyPresses("ctrl+z", "alt+1", "down", "enter")...)
+	if len(m.history.future) != 0 {
+		t.Errorf("%d changes left to redo, want 0", len(m.history.future))
+	}
+}
+
+func TestUndoMetadataEdit(t *testing.T) {
+	m := newReadyModel(t)
+
+	// Edit the group, leaving the metadata without submitting it.
+	m = send(m, keyPresses("alt+6", "enter", "i", "o", "tab")...)
+	if m.metadata.IsTyping() {
+		t.Fatal("the field is still being edited after leaving the metadata")
+	}
+	if got := m.currentConfig().Metadata["groupId"]; got != "io" {
+		t.Errorf("groupId = %q, want io", got)
+	}
+	if len(m.history.past) != 1 {
+		t.Fatalf("recorded %d changes, want the edit as one", len(m.history.past))
+	}
+
+	// Undo and redo are left to the field being edited.
+	m = send(m, keyPresses("alt+6", "enter", "ctrl+z")...)
+	if !m.metadata.IsTyping() || len(m.history.past) != 1 {
+		t.Errorf("ctrl+z undid a change while typing")
+	}
+	m = send(m, keyPresses("esc", "ctrl+z")...)
+	if got := m.currentConfig().Metadata["groupId"]; got != "com.example" {
+		t.Errorf("groupId after undo = %q, want com.example", got)
+	}
+}
@@ -0,0 +1,73 @@
+package mainModel
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/eslam-allam/spring-initializer-go/models/buttons"
+	"github.com/eslam-allam/spring-initializer-go/models/hookLog"
+	"github.com/eslam-allam/spring-initializer-go/models/notification"
+	"github.com/eslam-allam/spring-initializer-go/models/overlay"
+	"github.com/eslam-allam/spring-initializer-go/service/hooks"
+)
+
+// extractedMsg is sent once a project is extracted into projectDir, left
+// empty when the files of the project aren't in a single folder.
+type extractedMsg struct {
+	buttons.ActionStateMessage
+	projectDir string
+	initGit    bool
+}
+
+// hookMsg carries an event of the running hooks along with the channel the
+// following ones are read from.
+type hookMsg struct {
+	event  hooks.Event
+	events <-chan hooks.Event
+}
+
+type hooksFinishedMsg struct{}
+
+func waitForHook(events <-chan hooks.Event) tea.Cmd {
+	return func() tea.Msg {
+		event, ok := <-events
+		if !ok {
+			return hooksFinishedMsg{}
+		}
+		return hookMsg{event: event, events: events}
+	}
+}
+
+// runHooks starts the post-generation hooks inside projectDir, followed by a
+// git step when initGit is set, and shows their log.
+func (m *model) runHooks(projectDir string, initGit bool) tea.Cmd {
+	m.running = m.hooks
+	if initGit {
+		m.running = hooks.WithGit(m.hooks)
+	}
+	if len(m.running) == 0 {
+		return nil
+	}
+	m.hookFailures = 0
+	log := hookLog.New(m.running...)
+	log.SetTheme(m.theme)
+	// The log is pushed before the first event reaches it.
+	return tea.Sequence(overlay.Push(log), waitForHook(hooks.Run(m.running, projectDir)))
+}
+
+func (m model) updateHooks(msg hookMsg) (model, tea.Cmd) {
+	if msg.event.Err != nil {
+		m.hookFailures++
+		logger.Printf("Hook %q failed: %v", m.running[msg.event.Step].Title(), msg.event.Err)
+	}
+	var cmd tea.Cmd
+	m.dialogs, cmd = m.dialogs.Update(msg.event)
+	return m, tea.Batch(cmd, waitForHook(msg.events))
+}
+
+func (m model) hooksFinished() tea.Cmd {
+	if m.hookFailures > 0 {
+		return notify(fmt.Sprintf("%d of %d hooks failed.", m.hookFailures, len(m.running)), notification.ERROR)
+	}
+	return notify("Hooks finished successfully.", notification.INFO)
+}
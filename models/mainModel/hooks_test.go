@@ -0,0 +1,65 @@
+package mainModel
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/eslam-allam/spring-initializer-go/models/buttons"
+	"github.com/eslam-allam/spring-initializer-go/models/notification"
+	"github.com/eslam-allam/spring-initializer-go/service/hooks"
+)
+
+// collect runs cmd along with the commands it batches, returning their
+// messages.
+func collect(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+	msgs := make([]tea.Msg, 0)
+	for _, c := range batch {
+		msgs = append(msgs, collect(c)...)
+	}
+	return msgs
+}
+
+func TestExtractedWithoutProjectFolder(t *testing.T) {
+	extracted := extractedMsg{ActionStateMessage: buttons.ActionStateMessage{State: buttons.ACTION_SUCCESS, Message: "Project Generated Successfully!"}}
+	tests := []struct {
+		name    string
+		options []modelOption
+		initGit bool
+		warns   bool
+	}{
+		{"nothing to skip", nil, false, false},
+		{"hooks", []modelOption{WithHooks(hooks.Hook{Run: "./mvnw -q verify"})}, false, true},
+		{"git", nil, true, true},
+		{"templates", []modelOption{WithTemplates(t.TempDir(), "")}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newReadyModel(t, tt.options...)
+			msg := extracted
+			msg.initGit = tt.initGit
+			next, cmd := m.Update(msg)
+			m = next.(model)
+
+			warned := false
+			for _, msg := range collect(cmd) {
+				if n, ok := msg.(notification.NotificationMsg); ok && n.Level == notification.WARNING {
+					warned = true
+				}
+			}
+			if warned != tt.warns {
+				t.Errorf("warned = %v, want %v", warned, tt.warns)
+			}
+			if len(m.running) != 0 {
+				t.Errorf("ran %+v outside of a project folder", m.running)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package mainModel
+
+import (
+	"math"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// rect is an area of the screen in cells.
+type rect struct {
+	x, y, width, height int
+}
+
+func (r rect) contains(x, y int) bool {
+	return x >= r.x && x < r.x+r.width && y >= r.y && y < r.y+r.height
+}
+
+// block mirrors a rendered string while it is being joined with others,
+// keeping track of where each section ended up inside of it.
+type block struct {
+	width, height int
+	areas         map[section]rect
+}
+
+func newBlock(s string, sections ...section) block {
+	w, h := lipgloss.Size(s)
+	b := block{width: w, height: h, areas: make(map[section]rect)}
+	for _, sec := range sections {
+		b.areas[sec] = rect{width: w, height: h}
+	}
+	return b
+}
+
+func (b block) offset(x, y int) map[section]rect {
+	areas := make(map[section]rect, len(b.areas))
+	for sec, r := range b.areas {
+		r.x += x
+		r.y += y
+		areas[sec] = r
+	}
+	return areas
+}
+
+// joinHorizontal follows lipgloss.JoinHorizontal with lipgloss.Center.
+func joinHorizontal(blocks ...block) block {
+	joined := block{areas: make(map[section]rect)}
+	for _, b := range blocks {
+		joined.height = max(joined.height, b.height)
+	}
+	for _, b := range blocks {
+		top := int(math.Round(float64(joined.height-b.height) * 0.5))
+		for sec, r := range b.offset(joined.width, top) {
+			joined.areas[sec] = r
+		}
+		joined.width += b.width
+	}
+	return joined
+}
+
+// joinVertical follows lipgloss.JoinVertical with lipgloss.Center.
+func joinVertical(blocks ...block) block {
+	joined := block{areas: make(map[section]rect)}
+	for _, b := range blocks {
+		joined.width = max(joined.width, b.width)
+	}
+	for _, b := range blocks {
+		left := int(math.Round(float64(joined.width-b.width) * 0.5))
+		for sec, r := range b.offset(left, joined.height) {
+			joined.areas[sec] = r
+		}
+		joined.height += b.height
+	}
+	return joined
+}
+
+// sectionAreas returns where each section is drawn on screen. It has to be
+// kept in sync with the joins done in View.
+func (m model) sectionAreas() map[section]rect {
+	m.updateHelp()
+	sections := m.renderSections()
+	sectionBlock := func(sec section) block {
+		return newBlock(sections[sec], sec)
+	}
+
+	var content block
+	switch {
+	case m.wizard:
+		blocks := []block{newBlock(m.wizardIndicator())}
+		for _, sec := range wizardSteps[m.step].sections {
+			blocks = append(blocks, sectionBlock(sec))
+		}
+		content = joinVertical(append(blocks, newBlock(m.help.View(m.keys)))...)
+	case m.compact:
+		content = joinVertical(newBlock(m.stepIndicator()), sectionBlock(m.currentSection), newBlock(m.help.View(m.keys)))
+	default:
+		content = m.gridBlock(sectionBlock)
+	}
+
+	// renderMain centers the content inside of the document frame.
+	h, v := docStyle.GetFrameSize()
+	left := docStyle.GetBorderLeftSize() + docStyle.GetPaddingLeft() + max(m.width-h-content.width, 0)/2
+	top := docStyle.GetBorderTopSize() + docStyle.GetPaddingTop() + max(m.height-v-content.height, 0)/2
+	return content.offset(left, top)
+}
+
+// gridBlock follows renderGrid.
+func (m model) gridBlock(sectionBlock func(section) block) block {
+	leftSection := joinVertical(
+		joinHorizontal(sectionBlock(PROJECT), joinVertical(sectionBlock(LANGUAGE), sectionBlock(PACKAGING))),
+		joinHorizontal(sectionBlock(JAVA), sectionBlock(SPRING_BOOT)),
+		sectionBlock(METADATA),
+	)
+	rightSection := joinVertical(sectionBlock(DEPENDENCIES), sectionBlock(BUTTONS))
+	return joinVertical(joinHorizontal(leftSection, rightSection), newBlock(m.help.View(m.keys)))
+}
+
+// sectionAt returns the section under the mouse along with the event
+// translated to be relative to that section's content.
+func (m model) sectionAt(msg tea.MouseMsg) (section, tea.MouseMsg, bool) {
+	for sec, r := range m.sectionAreas() {
+		if !r.contains(msg.X, msg.Y) {
+			continue
+		}
+		msg.X -= r.x + sectionStyle.GetBorderLeftSize() + sectionStyle.GetPaddingLeft()
+		msg.Y -= r.y + sectionStyle.GetBorderTopSize() + sectionStyle.GetPaddingTop()
+		return sec, msg, true
+	}
+	return 0, msg, false
+}
@@ -0,0 +1,74 @@
+package mainModel
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-runewidth"
+)
+
+// cut returns the cells of the view covered by r. The view is rendered
+// without colors in the tests.
+func cut(view string, r rect) []string {
+	lines := strings.Split(view, "\n")
+	cells := make([]string, 0, r.height)
+	for y := r.y; y < r.y+r.height && y < len(lines); y++ {
+		var line strings.Builder
+		x := 0
+		for _, c := range lines[y] {
+			if x >= r.x && x < r.x+r.width {
+				line.WriteRune(c)
+			}
+			x += runewidth.RuneWidth(c)
+		}
+		cells = append(cells, line.String())
+	}
+	return cells
+}
+
+func TestSectionAreasMatchView(t *testing.T) {
+	layouts := []struct {
+		name    string
+		options []modelOption
+		size    tea.WindowSizeMsg
+	}{
+		{"grid", nil, tea.WindowSizeMsg{Width: 160, Height: 50}},
+		{"compact", nil, tea.WindowSizeMsg{Width: 60, Height: 40}},
+		{"wizard", []modelOption{WithWizard(true)}, tea.WindowSizeMsg{Width: 160, Height: 50}},
+	}
+	for _, tt := range layouts {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newReadyModel(t, tt.options...)
+			m = send(m, tt.size)
+			view := m.View()
+			sections := m.renderSections()
+			areas := m.sectionAreas()
+			if len(areas) == 0 {
+				t.Fatal("no section is laid out")
+			}
+			for sec, r := range areas {
+				want := strings.Split(sections[sec], "\n")
+				got := cut(view, r)
+				if strings.Join(got, "\n") != strings.Join(want, "\n") {
+					t.Errorf("%s is at %+v, but the view there is\n%s\nwant\n%s", sectionTitles[sec], r, strings.Join(got, "\n"), sections[sec])
+				}
+			}
+		})
+	}
+}
+
+func TestClickSection(t *testing.T) {
+	m := newReadyModel(t)
+	r := m.sectionAreas()[JAVA]
+	m = send(m, tea.MouseMsg{X: r.x + r.width/2, Y: r.y + r.height/2, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress})
+	if m.currentSection != JAVA {
+		t.Errorf("section = %v, want JAVA", m.currentSection)
+	}
+
+	// Clicking past the sections leaves the focus where it was.
+	m = send(m, tea.MouseMsg{X: 0, Y: 0, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress})
+	if m.currentSection != JAVA {
+		t.Errorf("section = %v after clicking outside, want JAVA", m.currentSection)
+	}
+}
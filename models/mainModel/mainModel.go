@@ -1,11 +1,15 @@
 package mainModel
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"math"
 	"os"
 	"path"
+	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/help"
@@ -16,12 +20,22 @@ import (
 	"github.com/eslam-allam/spring-initializer-go/constants"
 	"github.com/eslam-allam/spring-initializer-go/models/buttons"
 	"github.com/eslam-allam/spring-initializer-go/models/dependency"
+	"github.com/eslam-allam/spring-initializer-go/models/dialog"
+	"github.com/eslam-allam/spring-initializer-go/models/keymap"
 	"github.com/eslam-allam/spring-initializer-go/models/metadata"
 	"github.com/eslam-allam/spring-initializer-go/models/notification"
 	"github.com/eslam-allam/spring-initializer-go/models/overlay"
+	"github.com/eslam-allam/spring-initializer-go/models/picker"
+	"github.com/eslam-allam/spring-initializer-go/models/prompt"
 	"github.com/eslam-allam/spring-initializer-go/models/radioList"
+	"github.com/eslam-allam/spring-initializer-go/models/review"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
 	"github.com/eslam-allam/spring-initializer-go/service/files"
+	"github.com/eslam-allam/spring-initializer-go/service/hooks"
+	"github.com/eslam-allam/spring-initializer-go/service/importer"
+	"github.com/eslam-allam/spring-initializer-go/service/profile"
 	"github.com/eslam-allam/spring-initializer-go/service/springio"
+	"github.com/eslam-allam/spring-initializer-go/service/templates"
 )
 
 var logger *log.Logger = log.Default()
@@ -31,10 +45,25 @@ var (
 	sectionTitleStyle        lipgloss.Style = lipgloss.NewStyle().Bold(true)
 	currentSectionTitleStyle lipgloss.Style = sectionTitleStyle.Copy()
 	sectionStyle             lipgloss.Style = lipgloss.NewStyle().Border(lipgloss.NormalBorder(), true).
-					PaddingLeft(1).PaddingTop(1).BorderForeground(lipgloss.Color(constants.MainColour))
-	currentSectionStyle lipgloss.Style = sectionStyle.Copy().BorderForeground(lipgloss.Color(constants.HighlightColour))
+					PaddingLeft(1).PaddingTop(1)
 )
 
+type styles struct {
+	section        lipgloss.Style
+	currentSection lipgloss.Style
+	step           lipgloss.Style
+	currentStep    lipgloss.Style
+}
+
+func newStyles(t theme.Theme) styles {
+	return styles{
+		section:        t.Unfocused(sectionStyle),
+		currentSection: t.Focused(sectionStyle),
+		step:           t.Muted(),
+		currentStep:    t.Accent(),
+	}
+}
+
 type section int
 
 const NSECTIONS = 8
@@ -57,11 +86,32 @@ const (
 	READY
 )
 
+const (
+	profilePickerId = "profile"
+	saveProfileId   = "save-profile"
+	importPromptId  = "import"
+	paletteId       = "palette"
+	confirmationId  = "confirm-generate"
+)
+
 type model struct {
 	help              help.Model
 	currentHelp       string
 	targetDirectory   string
+	profileName       string
+	shareUrl          string
+	importPath        string
+	warnings          []string
+	profiles          []profile.Profile
+	profilePicker     picker.Model
+	palette           picker.Model
+	profilePrompt     prompt.Model
+	importPrompt      prompt.Model
+	confirmation      dialog.Model
+	dialogs           overlay.Stack
 	keys              MainKeyMap
+	theme             theme.Theme
+	styles            styles
 	spinner           spinner.Model
 	metadata          metadata.Model
 	notification      notification.Model
@@ -73,26 +123,90 @@ type model struct {
 	project           radioList.Model
 	buttons           buttons.Model
 	state             appState
-	currentSection    section
-	width             int
-	height            int
+	// defaults are the selections offered by the metadata, restored by the
+	// palette's reset command.
+	defaults       springio.ProjectConfig
+	history        history
+	review         review.Model
+	currentSection section
+	step           int
+	compact        bool
+	wizard         bool
+	confirm        bool
+	width          int
+	height         int
+	// pendingAction waits for the confirmation before generating the project.
+	pendingAction buttons.Action
+	hooks         []hooks.Hook
+	// running are the hooks of the latest project, along with the git step
+	// when it was asked for.
+	running      []hooks.Hook
+	hookFailures int
+	// templateDir and profileTemplates are rendered into extracted projects,
+	// the template directory of the applied profile last.
+	templateDir      string
+	profileTemplates string
+	templateConflict templates.Conflict
 }
 
 type MainKeyMap struct {
-	NEXT_SECTION     key.Binding
-	PREV_SECTION     key.Binding
-	HELP             key.Binding
-	QUIT             key.Binding
-	SectionShortKeys []key.Binding
-	SectionFullKeys  [][]key.Binding
+	NEXT_SECTION      key.Binding
+	PREV_SECTION      key.Binding
+	HELP              key.Binding
+	QUIT              key.Binding
+	PROFILES          key.Binding
+	SAVE_PROFILE      key.Binding
+	IMPORT            key.Binding
+	PALETTE           key.Binding
+	NOTIFICATIONS     key.Binding
+	UNDO              key.Binding
+	REDO              key.Binding
+	GOTO_PROJECT      key.Binding
+	GOTO_LANGUAGE     key.Binding
+	GOTO_PACKAGING    key.Binding
+	GOTO_JAVA         key.Binding
+	GOTO_SPRING_BOOT  key.Binding
+	GOTO_METADATA     key.Binding
+	GOTO_DEPENDENCIES key.Binding
+	GOTO_BUTTONS      key.Binding
+	SectionShortKeys  []key.Binding
+	SectionFullKeys   [][]key.Binding
 }
 
 func (k MainKeyMap) ShortHelp() []key.Binding {
-	return append([]key.Binding{k.HELP, k.QUIT}, k.SectionShortKeys...)
+	return append([]key.Binding{k.HELP, k.PALETTE, k.QUIT}, k.SectionShortKeys...)
+}
+
+// gotoKeys returns the bindings jumping to each section.
+func (k MainKeyMap) gotoKeys() [NSECTIONS]key.Binding {
+	return [NSECTIONS]key.Binding{
+		PROJECT:      k.GOTO_PROJECT,
+		LANGUAGE:     k.GOTO_LANGUAGE,
+		PACKAGING:    k.GOTO_PACKAGING,
+		JAVA:         k.GOTO_JAVA,
+		SPRING_BOOT:  k.GOTO_SPRING_BOOT,
+		METADATA:     k.GOTO_METADATA,
+		DEPENDENCIES: k.GOTO_DEPENDENCIES,
+		BUTTONS:      k.GOTO_BUTTONS,
+	}
+}
+
+// gotoHelp sums up the section jumps as a single entry of the help.
+func (k MainKeyMap) gotoHelp() key.Binding {
+	bindings := k.gotoKeys()
+	keys := make([]string, 0, NSECTIONS)
+	for _, b := range bindings {
+		if b.Enabled() {
+			keys = append(keys, b.Keys()...)
+		}
+	}
+	first, last := bindings[0].Help().Key, bindings[NSECTIONS-1].Help().Key
+	return key.NewBinding(key.WithKeys(keys...), key.WithHelp(first+"…"+last, "jump to section"))
 }
 
 func (k MainKeyMap) FullHelp() [][]key.Binding {
-	return append([][]key.Binding{{k.NEXT_SECTION, k.PREV_SECTION}, {k.HELP, k.QUIT}}, k.SectionFullKeys...)
+	return append([][]key.Binding{{k.NEXT_SECTION, k.PREV_SECTION, k.gotoHelp()}, {k.HELP, k.PALETTE, k.QUIT}, {k.PROFILES, k.SAVE_PROFILE, k.IMPORT}, {k.UNDO, k.REDO, k.NOTIFICATIONS}},
+		k.SectionFullKeys...)
 }
 
 var defaultKeys MainKeyMap = MainKeyMap{
@@ -100,29 +214,329 @@ var defaultKeys MainKeyMap = MainKeyMap{
 	PREV_SECTION: key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "previous section")),
 	HELP:         key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "toggle help")),
 	QUIT:         key.NewBinding(key.WithKeys("ctrl+q"), key.WithHelp("ctrl+q", "quit")),
+	PROFILES:     key.NewBinding(key.WithKeys("ctrl+o"), key.WithHelp("ctrl+o", "open profile")),
+	SAVE_PROFILE: key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "save profile")),
+	IMPORT:       key.NewBinding(key.WithKeys("ctrl+u"), key.WithHelp("ctrl+u", "import")),
+	PALETTE:      key.NewBinding(key.WithKeys("ctrl+p"), key.WithHelp("ctrl+p", "commands")),
+	UNDO:         key.NewBinding(key.WithKeys("ctrl+z"), key.WithHelp("ctrl+z", "undo")),
+	REDO:         key.NewBinding(key.WithKeys("ctrl+y"), key.WithHelp("ctrl+y", "redo")),
+
+	NOTIFICATIONS: key.NewBinding(key.WithKeys("ctrl+n"), key.WithHelp("ctrl+n", "notifications")),
+
+	GOTO_PROJECT:      key.NewBinding(key.WithKeys("alt+1"), key.WithHelp("alt+1", "project")),
+	GOTO_LANGUAGE:     key.NewBinding(key.WithKeys("alt+2"), key.WithHelp("alt+2", "language")),
+	GOTO_PACKAGING:    key.NewBinding(key.WithKeys("alt+3"), key.WithHelp("alt+3", "packaging")),
+	GOTO_JAVA:         key.NewBinding(key.WithKeys("alt+4"), key.WithHelp("alt+4", "java")),
+	GOTO_SPRING_BOOT:  key.NewBinding(key.WithKeys("alt+5"), key.WithHelp("alt+5", "spring boot")),
+	GOTO_METADATA:     key.NewBinding(key.WithKeys("alt+6"), key.WithHelp("alt+6", "metadata")),
+	GOTO_DEPENDENCIES: key.NewBinding(key.WithKeys("alt+7"), key.WithHelp("alt+7", "dependencies")),
+	GOTO_BUTTONS:      key.NewBinding(key.WithKeys("alt+8"), key.WithHelp("alt+8", "generate")),
+}
+
+func init() {
+	// The main keys are handled before those of the focused section.
+	keymap.Register("main", &defaultKeys, "list", "horizontal-list", "metadata", "metadata-input", "notification",
+		"notification-history", "review", "buttons", "dependencies", "dependency-filter", "dependency-summary", "dependency-detail")
 }
 
 func (m model) generateProject() (fullPath string, isZip bool, err error) {
-	url, err := springio.GenerateDownloadRequest(m.project.GetSelected().Action,
-		m.project.GetSelected().Id,
-		m.language.GetSelected().Id,
-		m.springBootVersion.GetSelected().Id,
-		m.packaging.GetSelected().Id,
-		m.javaVersion.GetSelected().Id,
-		m.dependencies.GetSelectedIds(),
-		m.metadata.GetValues(),
-	)
+	return springio.GenerateProject(m.project.GetSelected().Action, m.currentConfig(), m.targetDirectory)
+}
+
+// runAction downloads the project, extracting it when asked to. An extracted
+// project is also made a git repository when initGit is set.
+func (m model) runAction(action buttons.Action, initGit bool) tea.Cmd {
+	var cmd tea.Cmd
+	switch action {
+	case buttons.DOWNLOAD:
+		cmd = func() tea.Msg {
+			_, _, err := m.generateProject()
+			if err != nil {
+				logger.Printf("%v", err)
+				return downloadFailed(err)
+			}
+			return buttons.ActionStateMessage{
+				State:   buttons.ACTION_SUCCESS,
+				Message: "File Downloaded Successfully!",
+			}
+		}
+	case buttons.DOWNLOAD_EXTRACT:
+		cmd = func() tea.Msg {
+			fullPath, isZip, err := m.generateProject()
+			if err != nil {
+				logger.Printf("%v", err)
+				return downloadFailed(err)
+			}
+			if isZip {
+				root, err := files.ZipRoot(fullPath)
+				if err != nil {
+					logger.Printf("Error reading zip file: %v", err)
+					return buttons.ActionStateMessage{
+						State:   buttons.ACTION_FAILED,
+						Message: fmt.Sprintf("Failed to read zip file: %s", err),
+					}
+				}
+				projectDir := filepath.Join(m.targetDirectory, root)
+				err = files.UnzipFile(fullPath, m.targetDirectory)
+				if err != nil {
+					logger.Printf("Error unzipping file: %v", err)
+					return buttons.ActionStateMessage{
+						State:   buttons.ACTION_FAILED,
+						Message: fmt.Sprintf("Failed to extract project: %s", err),
+					}
+				}
+				state := buttons.ActionStateMessage{
+					State:   buttons.ACTION_SUCCESS,
+					Message: "Project Generated Successfully!",
+				}
+				err = os.Remove(fullPath)
+				if err != nil {
+					logger.Printf("Error deleting zip file: %v", err)
+					state.Message = fmt.Sprintf("Project extracted but could not delete zip: %s", err)
+				}
+				if root == "" {
+					// Templates and hooks would land in the target directory.
+					return extractedMsg{ActionStateMessage: state, initGit: initGit}
+				}
+				summary, err := m.applyTemplates(projectDir)
+				if err != nil {
+					logger.Printf("Error applying templates: %v", err)
+					return buttons.ActionStateMessage{
+						State:   buttons.ACTION_FAILED,
+						Message: fmt.Sprintf("Project extracted but templates failed: %s", err),
+					}
+				}
+				if summary != "" {
+					state.Message += " " + summary
+				}
+				return extractedMsg{ActionStateMessage: state, projectDir: projectDir, initGit: initGit}
+			}
+			return buttons.ActionStateMessage{
+				State:   buttons.ACTION_SUCCESS,
+				Message: "Project Generated Successfully!",
+			}
+		}
+	}
+
+	return cmd
+}
+
+func (m model) currentConfig() springio.ProjectConfig {
+	values := make(map[string]string)
+	for _, value := range m.metadata.GetValues() {
+		values[value.Id] = value.Value
+	}
+	return springio.ProjectConfig{
+		Type:         m.project.GetSelected().Id,
+		Language:     m.language.GetSelected().Id,
+		BootVersion:  m.springBootVersion.GetSelected().Id,
+		Packaging:    m.packaging.GetSelected().Id,
+		JavaVersion:  m.javaVersion.GetSelected().Id,
+		Metadata:     values,
+		Dependencies: m.dependencies.GetSelectedIds(),
+	}
+}
+
+// applyConfig selects every value set in c, returning a description of the
+// values that the current metadata doesn't offer.
+func (m *model) applyConfig(c springio.ProjectConfig) []string {
+	unknown := make([]string, 0)
+	for _, s := range []struct {
+		list  *radioList.Model
+		id    string
+		value string
+	}{
+		{&m.project, "type", c.Type},
+		{&m.language, "language", c.Language},
+		{&m.springBootVersion, "bootVersion", c.BootVersion},
+		{&m.packaging, "packaging", c.Packaging},
+		{&m.javaVersion, "javaVersion", c.JavaVersion},
+	} {
+		if s.value != "" && !s.list.Select(s.value) {
+			unknown = append(unknown, fmt.Sprintf("%s=%s", s.id, s.value))
+		}
+	}
+
+	for _, id := range m.metadata.SetValues(c.Metadata) {
+		unknown = append(unknown, fmt.Sprintf("%s=%s", id, c.Metadata[id]))
+	}
+
+	if c.Dependencies != nil {
+		for _, id := range m.dependencies.SetSelected(c.Dependencies) {
+			unknown = append(unknown, fmt.Sprintf("dependency=%s", id))
+		}
+	}
+	return unknown
+}
+
+// applyNamed applies c and notifies about the outcome, listing any values
+// that couldn't be selected.
+func (m *model) applyNamed(name string, c springio.ProjectConfig) tea.Cmd {
+	unknown := m.applyConfig(c)
+	if len(unknown) > 0 {
+		return notify(fmt.Sprintf("%s applied but some values are not available: %s",
+			name, strings.Join(unknown, ", ")), notification.WARNING)
+	}
+	return notify(fmt.Sprintf("%s applied.", name), notification.INFO)
+}
+
+func (m *model) applyProfile(p profile.Profile) tea.Cmd {
+	m.profileTemplates = p.Templates
+	return m.applyNamed(fmt.Sprintf("Profile %q", p.Name), p.ProjectConfig)
+}
+
+func (m *model) applyShareUrl(raw string) tea.Cmd {
+	c, err := springio.ParseShareUrl(raw)
 	if err != nil {
-		return fullPath, isZip, fmt.Errorf("error generating download request: %v", err)
+		logger.Printf("Error parsing share url: %v", err)
+		return notify(fmt.Sprintf("Failed to import share url: %s", err), notification.ERROR)
 	}
+	return m.applyNamed("Share url", c)
+}
 
-	baseName := path.Base(url.Path)
-	fullPath = path.Join(m.targetDirectory, baseName)
-	err = springio.DownloadGeneratedZip(url.String(), fullPath)
+// importBuildFile applies the settings read from a pom.xml or build.gradle,
+// listing the artifacts that have no matching dependency.
+func (m *model) importBuildFile(target string) tea.Cmd {
+	target, err := files.ExpandPath(target)
+	if err != nil {
+		logger.Printf("Error expanding import path: %v", err)
+		return notify(fmt.Sprintf("Failed to import build file: %s", err), notification.ERROR)
+	}
+	result, err := importer.Import(target, m.dependencies.Ids())
 	if err != nil {
-		return fullPath, isZip, fmt.Errorf("error downloading zip: %v", err)
+		logger.Printf("Error importing build file: %v", err)
+		return notify(fmt.Sprintf("Failed to import build file: %s", err), notification.ERROR)
+	}
+
+	name := path.Base(result.BuildFile)
+	unknown := m.applyConfig(result.Config)
+	for _, artifact := range result.Unmapped {
+		unknown = append(unknown, fmt.Sprintf("artifact=%s", artifact))
 	}
-	return fullPath, strings.HasSuffix(baseName, "zip"), nil
+	if len(unknown) > 0 {
+		return notify(fmt.Sprintf("%s imported but some values could not be mapped: %s",
+			name, strings.Join(unknown, ", ")), notification.WARNING)
+	}
+	return notify(fmt.Sprintf("%s imported.", name), notification.INFO)
+}
+
+// importFrom applies either a share url or a build file depending on what
+// was entered in the import prompt.
+func (m *model) importFrom(value string) tea.Cmd {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://") {
+		return m.applyShareUrl(value)
+	}
+	return m.importBuildFile(value)
+}
+
+// startup applies the profile, build file and share url requested on the
+// command line, offering the profile picker when none was given, and shows
+// the warnings found on launch.
+func (m *model) startup() tea.Cmd {
+	cmds := make([]tea.Cmd, 0)
+	if m.profileName != "" {
+		p, err := profile.Load(m.profileName)
+		if err != nil {
+			logger.Printf("Error loading profile: %v", err)
+			cmds = append(cmds, notify(fmt.Sprintf("Failed to load profile: %s", err), notification.ERROR))
+		} else {
+			cmds = append(cmds, m.applyProfile(p))
+		}
+	}
+	if m.importPath != "" {
+		cmds = append(cmds, m.importBuildFile(m.importPath))
+	}
+	if m.shareUrl != "" {
+		cmds = append(cmds, m.applyShareUrl(m.shareUrl))
+	}
+	if len(cmds) == 0 && len(m.profiles) > 0 {
+		cmds = append(cmds, overlay.Push(m.profilePicker))
+	}
+	if len(m.warnings) > 0 {
+		cmds = append(cmds, notify(strings.Join(m.warnings, "\n"), notification.WARNING))
+	}
+	return tea.Sequence(cmds...)
+}
+
+// share notifies with the share link, curl and HTTPie commands of the
+// current configuration, each of which can be copied on its own.
+func (m model) share() tea.Cmd {
+	c := m.currentConfig()
+	action := m.project.GetSelected().Action
+	curl, err := springio.CurlCommand(action, c)
+	if err != nil {
+		logger.Printf("Error building curl command: %v", err)
+		return notify(fmt.Sprintf("Failed to build curl command: %s", err), notification.ERROR)
+	}
+	httpie, err := springio.HttpieCommand(action, c)
+	if err != nil {
+		logger.Printf("Error building HTTPie command: %v", err)
+		return notify(fmt.Sprintf("Failed to build HTTPie command: %s", err), notification.ERROR)
+	}
+
+	options := []notification.CopyOption{
+		{Key: "u", Label: "link", Text: springio.ShareUrl(c)},
+		{Key: "c", Label: "curl", Text: curl},
+		{Key: "h", Label: "httpie", Text: httpie},
+	}
+	message := strings.Builder{}
+	for i, option := range options {
+		if i > 0 {
+			message.WriteString("\n\n")
+		}
+		message.WriteString(fmt.Sprintf("%s:\n%s", option.Label, option.Text))
+	}
+	return func() tea.Msg {
+		return notification.NotificationMsg{
+			Message: message.String(),
+			Level:   notification.INFO,
+			Options: options,
+		}
+	}
+}
+
+// downloadFailed reports a failed download. Errors returned by Spring
+// Initializr are summed up with a hint when possible, keeping the response in
+// the details.
+func downloadFailed(err error) buttons.ActionStateMessage {
+	var initializrErr *springio.InitializrError
+	if !errors.As(err, &initializrErr) {
+		return buttons.ActionStateMessage{
+			State:   buttons.ACTION_FAILED,
+			Message: fmt.Sprintf("Failed to Download file: %s", err),
+		}
+	}
+	message := fmt.Sprintf("Failed to Download file: %s", initializrErr.Message)
+	if hint := initializrErr.Hint(); hint != "" {
+		message += "\n\n" + hint
+	}
+	return buttons.ActionStateMessage{
+		State:   buttons.ACTION_FAILED,
+		Message: message,
+		Details: initializrErr.Details(),
+	}
+}
+
+func notify(message string, level notification.NotificationLevel) tea.Cmd {
+	return func() tea.Msg {
+		return notification.NotificationMsg{
+			Message: message,
+			Level:   level,
+		}
+	}
+}
+
+func profileItems(profiles []profile.Profile) []picker.Item {
+	items := make([]picker.Item, len(profiles))
+	for i, p := range profiles {
+		items[i] = picker.Item{
+			Id:          p.Name,
+			Name:        p.Name,
+			Description: fmt.Sprintf("%d dependencies", len(p.Dependencies)),
+		}
+	}
+	return items
 }
 
 func initialModel() model {
@@ -177,10 +591,12 @@ func initialModel() model {
 		for _, groupItem := range dependencyGroup.Values {
 			dependencies = append(dependencies,
 				dependency.Dependency{
-					GroupName:   dependencyGroup.Name,
-					Id:          groupItem.Id,
-					Name:        groupItem.Name,
-					Description: groupItem.Description,
+					GroupName:     dependencyGroup.Name,
+					Id:            groupItem.Id,
+					Name:          groupItem.Name,
+					Description:   groupItem.Description,
+					Compatibility: springio.FormatVersionRange(groupItem.VersionRange),
+					Links:         dependencyLinks(groupItem.Links),
 				})
 		}
 	}
@@ -191,7 +607,14 @@ func initialModel() model {
 			Name: version.Name,
 		}
 	}
+
+	profiles, err := profile.List()
+	if err != nil {
+		logger.Printf("Error listing profiles: %v", err)
+	}
+
 	return model{
+		profiles:          profiles,
 		project:           radioList.New(radioList.VERTICAL, projects...),
 		language:          radioList.New(radioList.VERTICAL, language...),
 		springBootVersion: radioList.New(radioList.VERTICAL, bootVersions...),
@@ -204,10 +627,45 @@ func initialModel() model {
 		buttons: buttons.New([]buttons.Button{
 			{Name: "Download", Action: buttons.DOWNLOAD},
 			{Name: "Download and Extract", Action: buttons.DOWNLOAD_EXTRACT},
+			{Name: "Share", Action: buttons.SHARE},
 		}...),
 	}
 }
 
+// linkOrder lists the relations shown first in the dependency details.
+var linkOrder = []string{"reference", "guide", "sample"}
+
+func dependencyLinks(links map[string]springio.LinkList) []dependency.Link {
+	rels := make([]string, 0, len(links))
+	for rel := range links {
+		rels = append(rels, rel)
+	}
+	rank := func(rel string) int {
+		if i := slices.Index(linkOrder, rel); i >= 0 {
+			return i
+		}
+		return len(linkOrder)
+	}
+	sort.Slice(rels, func(i, j int) bool {
+		if ri, rj := rank(rels[i]), rank(rels[j]); ri != rj {
+			return ri < rj
+		}
+		return rels[i] < rels[j]
+	})
+
+	result := make([]dependency.Link, 0)
+	for _, rel := range rels {
+		for _, link := range links[rel] {
+			title := link.Title
+			if title == "" {
+				title = strings.ToUpper(rel[:1]) + rel[1:]
+			}
+			result = append(result, dependency.Link{Rel: rel, Title: title, Href: link.Href})
+		}
+	}
+	return result
+}
+
 func sanitizeId(s string) string {
 	sanitized := strings.TrimSpace(s)
 	sanitized = strings.ReplaceAll(sanitized, " ", "-")
@@ -223,24 +681,39 @@ func (m model) Init() tea.Cmd {
 	})
 }
 
-func renderSection(title, s string, isCurrent bool) string {
-	section := sectionStyle.Render(s)
+func (m model) renderSection(title, s string, isCurrent bool) string {
+	section := m.styles.section.Render(s)
 	sectionTitle := sectionTitleStyle.Render(title)
 	if isCurrent {
-		section = currentSectionStyle.Render(s)
+		section = m.styles.currentSection.Render(s)
 		sectionTitle = currentSectionTitleStyle.Render(title)
 	}
 
-	return overlay.PlaceTitle(sectionTitle, section, 0, 0, currentSectionStyle.GetHorizontalFrameSize()/2+1)
+	return overlay.PlaceTitle(sectionTitle, section, 0, 0, sectionStyle.GetHorizontalFrameSize()/2+1)
 }
 
-func (m model) iteratingRenderer() func(title, s string) string {
-	i := 0
-	return func(title, s string) string {
-		section := renderSection(title, s, i == int(m.currentSection))
-		i++
-		return section
+// renderSections renders every section with its title, indexed by section.
+func (m model) renderSections() [NSECTIONS]string {
+	dependenciesTitle := sectionTitles[DEPENDENCIES]
+	if count := m.dependencies.SelectedCount(); count > 0 {
+		dependenciesTitle = fmt.Sprintf("%s (%d)", dependenciesTitle, count)
+	}
+	views := [NSECTIONS]struct{ title, view string }{
+		PROJECT:      {sectionTitles[PROJECT], m.project.View()},
+		LANGUAGE:     {sectionTitles[LANGUAGE], m.language.View()},
+		PACKAGING:    {sectionTitles[PACKAGING], m.packaging.View()},
+		JAVA:         {sectionTitles[JAVA], m.javaVersion.View()},
+		SPRING_BOOT:  {sectionTitles[SPRING_BOOT], m.springBootVersion.View()},
+		METADATA:     {sectionTitles[METADATA], m.metadata.View()},
+		DEPENDENCIES: {dependenciesTitle, m.dependencies.View()},
+		BUTTONS:      {sectionTitles[BUTTONS], m.buttons.View()},
 	}
+
+	var sections [NSECTIONS]string
+	for i, v := range views {
+		sections[i] = m.renderSection(fmt.Sprintf("%d %s", i+1, v.title), v.view, section(i) == m.currentSection)
+	}
+	return sections
 }
 
 func (m model) renderMain(content string) string {
@@ -253,44 +726,77 @@ func (m model) View() string {
 		return m.renderMain(lipgloss.JoinHorizontal(lipgloss.Center, m.spinner.View(), "Loading metadata from spring.io..."))
 	}
 
-	if m.width < constants.MinScreenWidth || m.height < constants.MinScreenHeight {
+	if m.width < constants.MinCompactWidth || m.height < constants.MinCompactHeight {
 		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center,
 			fmt.Sprintf("This screen is too small. (Min: %dx%d) (Current: %dx%d)",
-				constants.MinScreenWidth, constants.MinScreenHeight, m.width, m.height))
+				constants.MinCompactWidth, constants.MinCompactHeight, m.width, m.height))
 	}
 
 	m.updateHelp()
-	renderer := m.iteratingRenderer()
+	sections := m.renderSections()
+	var body string
+	switch {
+	case m.wizard:
+		body = m.renderWizard(sections)
+	case m.compact:
+		body = m.renderMain(lipgloss.JoinVertical(lipgloss.Center,
+			m.stepIndicator(), sections[m.currentSection], m.help.View(m.keys)))
+	default:
+		body = m.renderGrid(sections)
+	}
+
+	body = m.dialogs.Place(body)
+	if m.notification.IsActive() {
+		body = overlay.PlaceCenter(m.notification.View(), body)
+	}
+
+	return body
+}
+
+func (m model) renderGrid(sections [NSECTIONS]string) string {
 	leftSection := lipgloss.JoinVertical(lipgloss.Center,
-		lipgloss.JoinHorizontal(lipgloss.Center, renderer("Project", m.project.View()),
-			lipgloss.JoinVertical(lipgloss.Center, renderer("Language", m.language.View()), renderer("Packaging", m.packaging.View()))),
-		lipgloss.JoinHorizontal(lipgloss.Center,
-			renderer("Java", m.javaVersion.View()), renderer("Spring Boot", m.springBootVersion.View())),
-		renderer("Project Metadata", m.metadata.View()),
+		lipgloss.JoinHorizontal(lipgloss.Center, sections[PROJECT],
+			lipgloss.JoinVertical(lipgloss.Center, sections[LANGUAGE], sections[PACKAGING])),
+		lipgloss.JoinHorizontal(lipgloss.Center, sections[JAVA], sections[SPRING_BOOT]),
+		sections[METADATA],
 	)
-	rightSection := lipgloss.JoinVertical(lipgloss.Center, renderer("Dependencies", m.dependencies.View()), renderer("Generate", m.buttons.View()))
-	body := m.renderMain(
+	rightSection := lipgloss.JoinVertical(lipgloss.Center, sections[DEPENDENCIES], sections[BUTTONS])
+	return m.renderMain(
 		lipgloss.JoinVertical(lipgloss.Center,
 			lipgloss.JoinHorizontal(lipgloss.Top, leftSection, rightSection),
 			m.help.View(m.keys)))
+}
 
-	if m.notification.IsActive() {
-		h, v := lipgloss.Size(body)
-		notification := m.notification.View()
-		hn, vn := lipgloss.Size(notification)
-		verticalPos := math.Floor(float64(v)*0.5 - float64(vn)*0.5)
-		body = overlay.PlaceOverlay(h/2-hn/2, int(verticalPos), notification, body)
+// stepIndicator shows which of the sections is displayed in the compact
+// layout.
+func (m model) stepIndicator() string {
+	steps := make([]string, NSECTIONS)
+	for i := range steps {
+		if section(i) == m.currentSection {
+			steps[i] = m.styles.currentStep.Render("●")
+		} else {
+			steps[i] = m.styles.step.Render("○")
+		}
 	}
-
-	return body
+	return fmt.Sprintf("%s %d/%d", strings.Join(steps, " "), m.currentSection+1, NSECTIONS)
 }
 
 func (m *model) updateHelp() {
-	if m.notification.IsActive() {
+	if m.notification.Captures() {
 		m.keys.SectionShortKeys = m.notification.ShortHelp()
 		m.keys.SectionFullKeys = m.notification.FullHelp()
 		return
 	}
+	if m.dialogs.IsActive() {
+		m.keys.SectionShortKeys = m.dialogs.ShortHelp()
+		m.keys.SectionFullKeys = m.dialogs.FullHelp()
+		return
+	}
+	if m.inReview() {
+		m.keys.SectionShortKeys = m.review.ShortHelp()
+		m.keys.SectionFullKeys = m.review.FullHelp()
+		return
+	}
 	switch m.currentSection {
 	case PROJECT:
 		m.keys.SectionShortKeys = m.project.ShortHelp()
@@ -340,18 +846,129 @@ func cellDimentions(h, v int, mh, mv float64, cieling ...bool) (int, int) {
 	return int(rh), int(rv)
 }
 
+// resizeGrid sizes the sections to fit the full grid layout.
+func (m *model) resizeGrid() {
+	h, v := docStyle.GetFrameSize()
+	hs, vs := sectionStyle.GetFrameSize()
+	hs, vs = hs+1, vs+sectionTitleStyle.GetVerticalFrameSize()
+	cellDimentsionCalc := func(eh, ev int, mh, mv float64, cieling ...bool) (int, int) {
+		return cellDimentions(m.width-h-(hs*eh), m.height-v-(vs*ev)-2, mh, mv, cieling...)
+	}
+
+	cw, cv := cellDimentsionCalc(3, 4, 0.25, 0.2, false, false)
+	ph, pv := cw, cv+1+vs
+	m.project.SetSize(ph, pv)
+	m.language.SetSize(cw, cv)
+	m.packaging.SetSize(cw, 1)
+
+	_, cmv := cellDimentsionCalc(3, 3, 1, 1, false, false)
+	m.springBootVersion.SetSize(cw, cmv-5-pv)
+	m.javaVersion.SetSize(cw, cmv-5-pv)
+
+	c2w := cw*2 + hs - 1
+	m.metadata.SetSize(c2w, 5)
+
+	m.dependencies.SetSize(c2w, pv+(cmv-5-pv)+1)
+	m.buttons.SetSize(c2w, 5)
+
+	m.help.Width = c2w*2 - h - hs
+
+	m.notification.SetSize(c2w, cmv)
+	m.dialogs.SetSize(c2w, cmv)
+}
+
+// resizeCompact gives every section the whole screen, minus the step
+// indicator and the help, since only one of them is shown at a time.
+func (m *model) resizeCompact() {
+	h, v := docStyle.GetFrameSize()
+	hs, vs := sectionStyle.GetFrameSize()
+	cw, ch := m.width-h-hs, m.height-v-vs-lipgloss.Height(m.stepIndicator())-1
+
+	m.project.SetSize(cw, ch)
+	m.language.SetSize(cw, ch)
+	m.packaging.SetSize(cw, 1)
+	m.springBootVersion.SetSize(cw, ch)
+	m.javaVersion.SetSize(cw, ch)
+	m.metadata.SetSize(cw, ch)
+	// The dependency list draws its paginator and filter below its height.
+	m.dependencies.SetSize(cw, ch-2)
+	m.buttons.SetSize(cw, ch)
+
+	m.help.Width = m.width - h
+
+	m.notification.SetSize(cw, ch)
+	m.dialogs.SetSize(cw, ch)
+}
+
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	wasReady := m.state == READY
+	m, cmd := m.update(msg)
+	if m.currentSection != METADATA {
+		// Leaving the metadata ends the edit of its field, keeping what was
+		// typed, so that it is recorded and undone as a whole.
+		m.metadata.Blur()
+	}
+	switch {
+	case wasReady:
+		m.recordChange()
+	case m.state == READY:
+		// Whatever is applied on launch can't be undone.
+		m.history.reset(m.currentConfig())
+	}
+	return m, cmd
+}
+
+func (m model) update(msg tea.Msg) (model, tea.Cmd) {
 	var cmd tea.Cmd
 	switch msg := msg.(type) {
 
-	case notification.CopyDone:
+	case notification.CopyDone, notification.TimeoutMsg:
 		m.notification, cmd = m.notification.Update(msg)
 
+	case overlay.PushMsg:
+		m.dialogs, cmd = m.dialogs.Update(msg)
+
 	case notification.NotificationMsg:
-		m.notification.Activate()
-		m.notification = m.notification.UpdateMessage(msg)
+		m.notification, cmd = m.notification.Update(msg)
 		m.updateHelp()
 
+	case picker.PickedMsg:
+		if msg.Picker == paletteId {
+			m, cmd = m.runCommand(msg.Item.Id)
+			break
+		}
+		if msg.Picker != profilePickerId {
+			break
+		}
+		for _, p := range m.profiles {
+			if p.Name == msg.Item.Id {
+				cmd = m.applyProfile(p)
+				break
+			}
+		}
+
+	case prompt.SubmitMsg:
+		if msg.Prompt == importPromptId {
+			cmd = m.importFrom(msg.Value)
+			break
+		}
+		if msg.Prompt != saveProfileId {
+			break
+		}
+		p := profile.Profile{Name: strings.TrimSpace(msg.Value), ProjectConfig: m.currentConfig(), Templates: m.profileTemplates}
+		if err := profile.Save(p); err != nil {
+			logger.Printf("Error saving profile: %v", err)
+			cmd = notify(fmt.Sprintf("Failed to save profile: %s", err), notification.ERROR)
+			break
+		}
+		profiles, err := profile.List()
+		if err != nil {
+			logger.Printf("Error listing profiles: %v", err)
+		}
+		m.profiles = profiles
+		m.profilePicker.SetItems(profileItems(profiles)...)
+		cmd = notify(fmt.Sprintf("Profile %q saved.", p.Name), notification.INFO)
+
 	case model:
 		msg.height = m.height
 		msg.width = m.width
@@ -366,8 +983,33 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		msg.targetDirectory = m.targetDirectory
 		msg.help.Width = m.help.Width
 		msg.notification = m.notification
+		msg.profileName = m.profileName
+		msg.profilePicker = m.profilePicker
+		msg.palette = m.palette
+		msg.profilePrompt = m.profilePrompt
+		msg.importPrompt = m.importPrompt
+		msg.confirmation = m.confirmation
+		msg.dialogs = m.dialogs
+		msg.confirm = m.confirm
+		msg.hooks = m.hooks
+		msg.templateDir = m.templateDir
+		msg.profileTemplates = m.profileTemplates
+		msg.templateConflict = m.templateConflict
+		msg.shareUrl = m.shareUrl
+		msg.importPath = m.importPath
+		msg.warnings = m.warnings
+		msg.compact = m.compact
+		msg.wizard = m.wizard
+		msg.review = m.review
+		msg.keys = m.keys
+		msg.theme = m.theme
 		m = msg
 		m.state = READY
+		m.defaults = m.currentConfig()
+		m.applyTheme()
+
+		m.profilePicker.SetItems(profileItems(m.profiles)...)
+		cmd = m.startup()
 
 	case spinner.TickMsg:
 		switch m.state {
@@ -380,127 +1022,195 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case buttons.ActionStateMessage:
 		m.buttons, cmd = m.buttons.Update(msg)
 
+	case extractedMsg:
+		m.buttons, cmd = m.buttons.Update(msg.ActionStateMessage)
+		switch {
+		case msg.projectDir != "":
+			cmd = tea.Batch(cmd, m.runHooks(msg.projectDir, msg.initGit))
+		case len(m.hooks) > 0 || msg.initGit || m.hasTemplates():
+			cmd = tea.Batch(cmd, notify("The project isn't in a single folder, so its templates and hooks were skipped.", notification.WARNING))
+		}
+
+	case hookMsg:
+		m, cmd = m.updateHooks(msg)
+
+	case hooksFinishedMsg:
+		cmd = m.hooksFinished()
+
 	case buttons.Action:
-		switch msg {
-		case buttons.DOWNLOAD:
-			cmd = func() tea.Msg {
-				_, _, err := m.generateProject()
-				if err != nil {
-					logger.Printf("%v", err)
-					return buttons.ActionStateMessage{
-						State:   buttons.ACTION_FAILED,
-						Message: fmt.Sprintf("Failed to Download file: %s", err),
-					}
-				}
-				return buttons.ActionStateMessage{
-					State:   buttons.ACTION_SUCCESS,
-					Message: "File Downloaded Successfully!",
-				}
-			}
-		case buttons.DOWNLOAD_EXTRACT:
-			cmd = func() tea.Msg {
-				fullPath, isZip, err := m.generateProject()
-				if err != nil {
-					logger.Printf("%v", err)
-					return buttons.ActionStateMessage{
-						State:   buttons.ACTION_FAILED,
-						Message: fmt.Sprintf("Failed to Download file: %s", err),
-					}
-				}
-				if isZip {
-					err = files.UnzipFile(fullPath, m.targetDirectory)
-					if err != nil {
-						logger.Printf("Error unzipping file: %v", err)
-						return buttons.ActionStateMessage{
-							State:   buttons.ACTION_FAILED,
-							Message: fmt.Sprintf("Failed to extract project: %s", err),
-						}
-					}
-					err = os.Remove(fullPath)
-					if err != nil {
-						logger.Printf("Error deleting zip file: %v", err)
-						return buttons.ActionStateMessage{
-							State:   buttons.ACTION_SUCCESS,
-							Message: fmt.Sprintf("Project extracted but could not delete zip: %s", err),
-						}
-					}
-				}
-				return buttons.ActionStateMessage{
-					State:   buttons.ACTION_SUCCESS,
-					Message: "Project Generated Successfully!",
-				}
-			}
+		switch {
+		case msg == buttons.SHARE:
+			cmd = m.share()
+		case m.confirm:
+			m.pendingAction = msg
+			m.confirmation.SetBody(m.confirmationBody(msg))
+			m.confirmation.SetOption(m.confirmationOption(msg))
+			cmd = overlay.Push(m.confirmation)
+		default:
+			cmd = m.runAction(msg, false)
+		}
+
+	case dialog.ResultMsg:
+		if msg.Dialog == confirmationId {
+			cmd = m.confirmed(msg)
 		}
 
 	case tea.WindowSizeMsg:
-		h, v := docStyle.GetFrameSize()
 		m.width, m.height = msg.Width, msg.Height
-
-		hs, vs := sectionStyle.GetFrameSize()
-		hs, vs = hs+1, vs+sectionTitleStyle.GetVerticalFrameSize()
-		cellDimentsionCalc := func(eh, ev int, mh, mv float64, cieling ...bool) (int, int) {
-			return cellDimentions(m.width-h-(hs*eh), m.height-v-(vs*ev)-2, mh, mv, cieling...)
+		m.compact = m.width < constants.MinScreenWidth || m.height < constants.MinScreenHeight
+		switch {
+		case m.wizard:
+			m.resizeWizard()
+		case m.compact:
+			m.resizeCompact()
+		default:
+			m.resizeGrid()
 		}
 
-		cw, cv := cellDimentsionCalc(3, 4, 0.25, 0.2, false, false)
-		ph, pv := cw, cv+1+vs
-		m.project.SetSize(ph, pv)
-		m.language.SetSize(cw, cv)
-		m.packaging.SetSize(cw, 1)
+	case tea.MouseMsg:
+		if m.state != READY || m.width < constants.MinCompactWidth || m.height < constants.MinCompactHeight {
+			break
+		}
 
-		_, cmv := cellDimentsionCalc(3, 3, 1, 1, false, false)
-		m.springBootVersion.SetSize(cw, cmv-5-pv)
-		m.javaVersion.SetSize(cw, cmv-5-pv)
+		if m.notification.Captures() {
+			m.notification, cmd = m.notification.Update(msg)
+			return m, cmd
+		}
 
-		c2w := cw*2 + hs - 1
-		m.metadata.SetSize(c2w, 5)
+		if m.dialogs.IsActive() {
+			m.dialogs, cmd = m.dialogs.Update(msg)
+			return m, cmd
+		}
 
-		m.dependencies.SetSize(c2w, pv+(cmv-5-pv)+1)
-		m.buttons.SetSize(c2w, 5)
+		if m.inReview() {
+			m.review, cmd = m.review.Update(msg)
+			break
+		}
 
-		m.help.Width = c2w*2 - h - hs
+		sec, local, ok := m.sectionAt(msg)
+		if !ok {
+			break
+		}
+		if msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionPress {
+			m.currentSection = sec
+		} else if !tea.MouseEvent(msg).IsWheel() {
+			break
+		}
+		m, cmd = m.updateSection(sec, local)
 
-		m.notification.SetSize(c2w, cmv)
 	case tea.KeyMsg:
+		if key.Matches(msg, m.keys.QUIT) {
+			return m, tea.Quit
+		}
+
+		if m.dialogs.IsActive() {
+			m.dialogs, cmd = m.dialogs.Update(msg)
+			return m, cmd
+		}
+
 		switch {
+		case key.Matches(msg, m.keys.NEXT_SECTION) && m.wizard:
+			m.wizardNext()
+		case key.Matches(msg, m.keys.PREV_SECTION) && m.wizard:
+			m.wizardBack()
 		case key.Matches(msg, m.keys.NEXT_SECTION):
 			m.currentSection = (m.currentSection + 1) % NSECTIONS
 		case key.Matches(msg, m.keys.PREV_SECTION):
 			m.currentSection = (m.currentSection - 1 + NSECTIONS) % NSECTIONS
+		case key.Matches(msg, m.keys.gotoHelp()):
+			for sec, b := range m.keys.gotoKeys() {
+				if key.Matches(msg, b) {
+					m.focusSection(section(sec))
+				}
+			}
+		case m.metadata.IsTyping():
+			// Other shortcuts, such as ctrl+u, are left to the field being
+			// edited.
 		case key.Matches(msg, m.keys.HELP):
 			m.help.ShowAll = !m.help.ShowAll
-		case key.Matches(msg, m.keys.QUIT):
-			return m, tea.Quit
+		case key.Matches(msg, m.keys.PROFILES) && m.state == READY:
+			return m, overlay.Push(m.profilePicker)
+		case key.Matches(msg, m.keys.SAVE_PROFILE) && m.state == READY:
+			return m, overlay.Push(m.profilePrompt)
+		case key.Matches(msg, m.keys.IMPORT) && m.state == READY:
+			return m, overlay.Push(m.importPrompt)
+		case key.Matches(msg, m.keys.UNDO) && m.state == READY:
+			return m, m.undo()
+		case key.Matches(msg, m.keys.REDO) && m.state == READY:
+			return m, m.redo()
+		case key.Matches(msg, m.keys.PALETTE) && m.state == READY:
+			m.palette.SetItems(m.paletteItems()...)
+			return m, overlay.Push(m.palette)
+		case key.Matches(msg, m.keys.NOTIFICATIONS) && m.state == READY:
+			m.notification.OpenHistory()
+			return m, nil
 		}
 
-		if m.notification.IsActive() {
+		if m.notification.Handles(msg) {
 			m.notification, cmd = m.notification.Update(msg)
 			return m, cmd
 		}
 
-		switch m.currentSection {
-
-		case PROJECT:
-			m.project, cmd = m.project.Update(msg)
-		case LANGUAGE:
-			m.language, cmd = m.language.Update(msg)
-		case PACKAGING:
-			m.packaging, cmd = m.packaging.Update(msg)
-		case SPRING_BOOT:
-			m.springBootVersion, cmd = m.springBootVersion.Update(msg)
-		case JAVA:
-			m.javaVersion, cmd = m.javaVersion.Update(msg)
-		case METADATA:
-			m.metadata, cmd = m.metadata.Update(msg)
-		case DEPENDENCIES:
-			m.dependencies, cmd = m.dependencies.Update(msg)
-		case BUTTONS:
-			m.buttons, cmd = m.buttons.Update(msg)
+		if m.inReview() {
+			m.review, cmd = m.review.Update(msg)
+			break
 		}
+
+		m, cmd = m.updateSection(m.currentSection, msg)
+
+	default:
+		// Such as the blinking of the cursor of a prompt.
+		m.dialogs, cmd = m.dialogs.Update(msg)
 	}
 	return m, cmd
 }
 
+// updateSection hands the message over to the given section.
+func (m model) updateSection(sec section, msg tea.Msg) (model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch sec {
+	case PROJECT:
+		m.project, cmd = m.project.Update(msg)
+	case LANGUAGE:
+		m.language, cmd = m.language.Update(msg)
+	case PACKAGING:
+		m.packaging, cmd = m.packaging.Update(msg)
+	case SPRING_BOOT:
+		m.springBootVersion, cmd = m.springBootVersion.Update(msg)
+	case JAVA:
+		m.javaVersion, cmd = m.javaVersion.Update(msg)
+	case METADATA:
+		m.metadata, cmd = m.metadata.Update(msg)
+	case DEPENDENCIES:
+		m.dependencies.SetBootVersion(m.springBootVersion.GetSelected().Id)
+		m.dependencies, cmd = m.dependencies.Update(msg)
+	case BUTTONS:
+		m.buttons, cmd = m.buttons.Update(msg)
+	}
+	return m, cmd
+}
+
+// applyTheme restyles the main model along with every section and overlay.
+func (m *model) applyTheme() {
+	m.styles = newStyles(m.theme)
+	m.help.Styles = m.theme.Help()
+	m.project.SetTheme(m.theme)
+	m.language.SetTheme(m.theme)
+	m.packaging.SetTheme(m.theme)
+	m.springBootVersion.SetTheme(m.theme)
+	m.javaVersion.SetTheme(m.theme)
+	m.metadata.SetTheme(m.theme)
+	m.dependencies.SetTheme(m.theme)
+	m.buttons.SetTheme(m.theme)
+	m.review.SetTheme(m.theme)
+	m.notification.SetTheme(m.theme)
+	m.profilePicker.SetTheme(m.theme)
+	m.palette.SetTheme(m.theme)
+	m.profilePrompt.SetTheme(m.theme)
+	m.importPrompt.SetTheme(m.theme)
+	m.confirmation.SetTheme(m.theme)
+}
+
 type modelOption func(m *model)
 
 func WithSpinner(spinner spinner.Model) modelOption {
@@ -515,13 +1225,99 @@ func WithTargetDir(targetDirectory string) modelOption {
 	}
 }
 
+// WithProfile applies the named profile once the metadata is loaded instead
+// of offering the profile picker.
+func WithProfile(name string) modelOption {
+	return func(m *model) {
+		m.profileName = name
+	}
+}
+
+// WithShareUrl applies a start.spring.io share link once the metadata is
+// loaded.
+func WithShareUrl(url string) modelOption {
+	return func(m *model) {
+		m.shareUrl = url
+	}
+}
+
+// WithWarnings shows the given problems found on launch, such as invalid
+// settings, once the metadata is loaded.
+func WithWarnings(warnings ...string) modelOption {
+	return func(m *model) {
+		m.warnings = warnings
+	}
+}
+
+// WithImport applies the settings of an existing pom.xml or build.gradle
+// once the metadata is loaded.
+func WithImport(buildFile string) modelOption {
+	return func(m *model) {
+		m.importPath = buildFile
+	}
+}
+
+// WithTheme colours the whole UI using the given theme.
+func WithTheme(t theme.Theme) modelOption {
+	return func(m *model) {
+		m.theme = t
+	}
+}
+
+// WithConfirmation asks for confirmation before generating a project.
+func WithConfirmation(enabled bool) modelOption {
+	return func(m *model) {
+		m.confirm = enabled
+	}
+}
+
+// WithHooks runs the given steps inside every extracted project.
+func WithHooks(hs ...hooks.Hook) modelOption {
+	return func(m *model) {
+		m.hooks = hs
+	}
+}
+
+// WithTemplates renders the files of dir into every extracted project,
+// handling the files already generated as told by conflict.
+func WithTemplates(dir string, conflict templates.Conflict) modelOption {
+	return func(m *model) {
+		m.templateDir = dir
+		m.templateConflict = conflict
+	}
+}
+
+// WithWizard shows one step at a time, from the project type to a final
+// review, instead of the full grid.
+func WithWizard(enabled bool) modelOption {
+	return func(m *model) {
+		m.wizard = enabled
+	}
+}
+
 func New(options ...modelOption) model {
 	model := model{
-		notification: notification.New(),
+		theme:         theme.Default,
+		keys:          defaultKeys,
+		review:        review.New(),
+		notification:  notification.New(),
+		profilePicker: picker.New(profilePickerId, "Profiles"),
+		palette:       picker.New(paletteId, "Commands"),
+		profilePrompt: prompt.New(saveProfileId, "Save Profile", "Profile name..."),
+		importPrompt:  prompt.New(importPromptId, "Import", "Paste a share link or the path to a pom.xml or build.gradle..."),
+		confirmation: dialog.New(confirmationId, "Generate Project",
+			dialog.WithLabels("Generate", "Cancel"), dialog.WithDontAskAgain()),
+		confirm: true,
 	}
 
 	for _, opt := range options {
 		opt(&model)
 	}
+
+	model.applyTheme()
+	if model.wizard {
+		model.keys.NEXT_SECTION.SetHelp(model.keys.NEXT_SECTION.Help().Key, "next step")
+		model.keys.PREV_SECTION.SetHelp(model.keys.PREV_SECTION.Help().Key, "previous step")
+	}
 	return model
 }
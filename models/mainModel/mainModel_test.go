@@ -0,0 +1,184 @@
+package mainModel
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/help"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/eslam-allam/spring-initializer-go/models/buttons"
+	"github.com/eslam-allam/spring-initializer-go/models/dependency"
+	"github.com/eslam-allam/spring-initializer-go/models/metadata"
+	"github.com/eslam-allam/spring-initializer-go/models/notification"
+	"github.com/eslam-allam/spring-initializer-go/models/overlay"
+	"github.com/eslam-allam/spring-initializer-go/models/radioList"
+)
+
+// testMetadata stands in for the metadata of spring.io.
+func testMetadata() model {
+	return model{
+		project:           radioList.New(radioList.VERTICAL, radioList.Item{Name: "Maven", Id: "maven-project", Action: "/starter.zip"}, radioList.Item{Name: "Gradle - Kotlin", Id: "gradle-project-kotlin", Action: "/starter.zip"}),
+		language:          radioList.New(radioList.VERTICAL, radioList.Item{Name: "Java", Id: "java"}, radioList.Item{Name: "Kotlin", Id: "kotlin"}),
+		springBootVersion: radioList.New(radioList.VERTICAL, radioList.Item{Name: "3.3.0", Id: "3.3.0"}, radioList.Item{Name: "3.2.0", Id: "3.2.0"}),
+		javaVersion:       radioList.New(radioList.VERTICAL, radioList.Item{Name: "21", Id: "21"}, radioList.Item{Name: "17", Id: "17"}),
+		packaging:         radioList.New(radioList.HORIZONTAL, radioList.Item{Name: "Jar", Id: "jar"}, radioList.Item{Name: "War", Id: "war"}),
+		metadata: metadata.New(
+			metadata.NewField("Group", "groupId", "com.example"),
+			metadata.NewField("Artifact", "artifactId", "demo"),
+		),
+		dependencies: dependency.New(
+			dependency.Dependency{Id: "web", Name: "Spring Web", GroupName: "Web"},
+			dependency.Dependency{Id: "security", Name: "Spring Security", GroupName: "Security"},
+		),
+		help: help.New(),
+		keys: defaultKeys,
+		buttons: buttons.New([]buttons.Button{
+			{Name: "Download", Action: buttons.DOWNLOAD},
+			{Name: "Download and Extract", Action: buttons.DOWNLOAD_EXTRACT},
+			{Name: "Share", Action: buttons.SHARE},
+		}...),
+	}
+}
+
+// newReadyModel returns a model laid out on a large screen with the test
+// metadata loaded.
+func newReadyModel(t *testing.T, options ...modelOption) model {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+	t.Setenv("AppData", dir)
+
+	m := New(options...)
+	m = send(m, tea.WindowSizeMsg{Width: 160, Height: 50})
+	m = send(m, testMetadata())
+	if m.state != READY {
+		t.Fatalf("state = %v, want READY", m.state)
+	}
+	return m
+}
+
+func send(m model, msgs ...tea.Msg) model {
+	for _, msg := range msgs {
+		next, _ := m.Update(msg)
+		m = next.(model)
+	}
+	return m
+}
+
+func keyPress(k string) tea.KeyMsg {
+	switch k {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "tab":
+		return tea.KeyMsg{Type: tea.KeyTab}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	case "up":
+		return tea.KeyMsg{Type: tea.KeyUp}
+	case "ctrl+z":
+		return tea.KeyMsg{Type: tea.KeyCtrlZ}
+	case "ctrl+y":
+		return tea.KeyMsg{Type: tea.KeyCtrlY}
+	case "ctrl+s":
+		return tea.KeyMsg{Type: tea.KeyCtrlS}
+	}
+	if len(k) == 5 && k[:4] == "alt+" {
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k[4:]), Alt: true}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+}
+
+func keyPresses(keys ...string) []tea.Msg {
+	msgs := make([]tea.Msg, len(keys))
+	for i, k := range keys {
+		msgs[i] = keyPress(k)
+	}
+	return msgs
+}
+
+func TestDialogsTakeInput(t *testing.T) {
+	m := newReadyModel(t)
+	before := m.currentConfig()
+
+	next, cmd := m.Update(keyPress("ctrl+s"))
+	m = next.(model)
+	if cmd == nil {
+		t.Fatal("ctrl+s didn't open the save prompt")
+	}
+	push, ok := cmd().(overlay.PushMsg)
+	if !ok {
+		t.Fatalf("ctrl+s returned %T, want an overlay.PushMsg", push)
+	}
+	next, blink := m.Update(push)
+	m = next.(model)
+	if !m.dialogs.IsActive() {
+		t.Fatal("the save prompt isn't shown")
+	}
+
+	// The prompt keeps its cursor blinking.
+	if _, cmd := m.Update(blink()); cmd == nil {
+		t.Error("the blinking of the prompt's cursor didn't reach it")
+	}
+
+	// Neither the keys, the wheel nor the clicks reach the sections.
+	m = send(m, keyPresses("down", "j", "x")...)
+	m = send(m, tea.MouseMsg{Button: tea.MouseButtonWheelDown}, tea.MouseMsg{X: 5, Y: 5, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress})
+	if got := m.currentConfig(); !reflect.DeepEqual(got, before) || m.currentSection != PROJECT {
+		t.Errorf("the sections changed under the prompt: %+v", got)
+	}
+
+	m = send(m, keyPress("esc"))
+	if m.dialogs.IsActive() {
+		t.Error("esc didn't close the prompt")
+	}
+}
+
+func TestNotificationsLeaveInputBelow(t *testing.T) {
+	m := newReadyModel(t)
+	m = send(m, notification.NotificationMsg{Message: "Profile saved.", Level: notification.INFO})
+	m = send(m, keyPresses("down", "enter")...)
+	if got := m.currentConfig().Type; got != "gradle-project-kotlin" {
+		t.Errorf("type = %q, the keys didn't reach the section under an info", got)
+	}
+	m = send(m, keyPress("esc"))
+	if m.notification.IsActive() {
+		t.Error("esc didn't dismiss the info")
+	}
+
+	m = send(m, notification.NotificationMsg{Message: "Download failed.", Level: notification.ERROR})
+	m = send(m, keyPresses("up", "enter")...)
+	if got := m.currentConfig().Type; got != "gradle-project-kotlin" {
+		t.Errorf("type = %q, the keys went past an error", got)
+	}
+	m = send(m, tea.MouseMsg{X: 10, Y: 10, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress})
+	if m.notification.IsActive() {
+		t.Error("a click didn't dismiss the error")
+	}
+}
+
+func TestShortcutsLeftToTheFieldBeingEdited(t *testing.T) {
+	m := newReadyModel(t)
+	m = send(m, keyPresses("alt+6", "enter", "i", "o", "?")...)
+	if m.help.ShowAll {
+		t.Error("? toggled the help instead of being typed")
+	}
+	next, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+	m = next.(model)
+	if cmd != nil {
+		if _, ok := cmd().(overlay.PushMsg); ok {
+			t.Error("ctrl+u opened the import prompt while typing")
+		}
+	}
+	m = send(m, keyPresses("n", "e", "t", "enter")...)
+	if got := m.currentConfig().Metadata["groupId"]; got != "net" {
+		t.Errorf("groupId = %q, want ctrl+u to clear what was typed before it", got)
+	}
+
+	// Once the field is submitted the shortcuts are back.
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlU}); cmd == nil {
+		t.Error("ctrl+u didn't open the import prompt")
+	}
+}
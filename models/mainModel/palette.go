@@ -0,0 +1,146 @@
+package mainModel
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/eslam-allam/spring-initializer-go/models/buttons"
+	"github.com/eslam-allam/spring-initializer-go/models/dependency"
+	"github.com/eslam-allam/spring-initializer-go/models/notification"
+	"github.com/eslam-allam/spring-initializer-go/models/picker"
+	"github.com/eslam-allam/spring-initializer-go/models/radioList"
+)
+
+// Commands of the palette are picker items with an id of the form
+// "<kind>:<value>". Selecting a choice uses "select:<section>:<choice id>".
+const (
+	sectionCommand    = "section"
+	selectCommand     = "select"
+	dependencyCommand = "dependency"
+	buttonCommand     = "button"
+	resetCommand      = "reset"
+)
+
+var sectionTitles = [NSECTIONS]string{
+	PROJECT:      "Project",
+	LANGUAGE:     "Language",
+	PACKAGING:    "Packaging",
+	JAVA:         "Java",
+	SPRING_BOOT:  "Spring Boot",
+	METADATA:     "Project Metadata",
+	DEPENDENCIES: "Dependencies",
+	BUTTONS:      "Generate",
+}
+
+// selectable lists the sections picking one of several choices.
+func (m *model) selectable() []struct {
+	sec  section
+	list *radioList.Model
+} {
+	return []struct {
+		sec  section
+		list *radioList.Model
+	}{
+		{PROJECT, &m.project},
+		{LANGUAGE, &m.language},
+		{PACKAGING, &m.packaging},
+		{JAVA, &m.javaVersion},
+		{SPRING_BOOT, &m.springBootVersion},
+	}
+}
+
+func command(kind string, value any) string {
+	return fmt.Sprintf("%s:%v", kind, value)
+}
+
+// paletteItems lists every command available from the palette.
+func (m model) paletteItems() []picker.Item {
+	items := make([]picker.Item, 0)
+	gotoKeys := m.keys.gotoKeys()
+	for i, title := range sectionTitles {
+		items = append(items, picker.Item{
+			Id:          command(sectionCommand, i),
+			Name:        "Go to " + title,
+			Description: gotoKeys[i].Help().Key,
+		})
+	}
+	for _, b := range m.buttons.Buttons() {
+		items = append(items, picker.Item{Id: command(buttonCommand, int(b.Action)), Name: b.Name})
+	}
+	items = append(items, picker.Item{Id: resetCommand, Name: "Reset", Description: "restore the default selections"})
+
+	for _, s := range m.selectable() {
+		selected := s.list.GetSelected().Id
+		for _, choice := range s.list.Choices() {
+			item := picker.Item{
+				Id:   command(selectCommand, fmt.Sprintf("%d:%s", s.sec, choice.Id)),
+				Name: fmt.Sprintf("Select %s %s", sectionTitles[s.sec], choice.Name),
+			}
+			if choice.Id == selected {
+				item.Description = "selected"
+			}
+			items = append(items, item)
+		}
+	}
+
+	for _, dep := range m.dependencies.Dependencies() {
+		verb := "Add"
+		if _, ok := m.dependencies.Selected[dep.Id]; ok {
+			verb = "Remove"
+		}
+		items = append(items, picker.Item{
+			Id:          command(dependencyCommand, dep.Id),
+			Name:        fmt.Sprintf("%s dependency %s", verb, dep.Name),
+			Description: dep.GroupName,
+		})
+	}
+	return items
+}
+
+// focusSection moves the focus to the given section, switching to the step
+// showing it in the wizard.
+func (m *model) focusSection(sec section) {
+	if m.wizard {
+		for i, step := range wizardSteps {
+			if slices.Contains(step.sections, sec) {
+				m.goToStep(i, true)
+				break
+			}
+		}
+	}
+	m.currentSection = sec
+}
+
+// runCommand runs a command picked from the palette by handing it over to
+// the sub-model it belongs to.
+func (m model) runCommand(id string) (model, tea.Cmd) {
+	var cmd tea.Cmd
+	kind, value, _ := strings.Cut(id, ":")
+	switch kind {
+	case sectionCommand:
+		sec, _ := strconv.Atoi(value)
+		m.focusSection(section(sec))
+	case buttonCommand:
+		action, _ := strconv.Atoi(value)
+		m.focusSection(BUTTONS)
+		m.buttons, cmd = m.buttons.Update(buttons.PressMsg{Action: buttons.Action(action)})
+	case resetCommand:
+		m.applyConfig(m.defaults)
+		cmd = notify("Selections reset to the defaults.", notification.INFO)
+	case selectCommand:
+		sec, choice, _ := strings.Cut(value, ":")
+		for _, s := range m.selectable() {
+			if strconv.Itoa(int(s.sec)) == sec {
+				*s.list, cmd = s.list.Update(radioList.SelectMsg{Id: choice})
+			}
+		}
+	case dependencyCommand:
+		m.dependencies, cmd = m.dependencies.Update(dependency.ToggleMsg{Id: value})
+	default:
+		logger.Printf("Unknown palette command: %s", id)
+	}
+	return m, cmd
+}
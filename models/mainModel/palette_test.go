@@ -0,0 +1,97 @@
+package mainModel
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/eslam-allam/spring-initializer-go/models/buttons"
+	"github.com/eslam-allam/spring-initializer-go/models/overlay"
+	"github.com/eslam-allam/spring-initializer-go/models/picker"
+)
+
+func pick(id string) picker.PickedMsg {
+	return picker.PickedMsg{Picker: paletteId, Item: picker.Item{Id: id}}
+}
+
+func TestPaletteItems(t *testing.T) {
+	m := newReadyModel(t)
+	m = send(m, pick(command(dependencyCommand, "web")))
+
+	names := make(map[string]string)
+	selected := make([]string, 0)
+	for _, item := range m.paletteItems() {
+		names[item.Id] = item.Name
+		if item.Description == "selected" {
+			selected = append(selected, item.Name)
+		}
+	}
+	wantSelected := []string{"Select Project Maven", "Select Language Java", "Select Packaging Jar", "Select Java 21", "Select Spring Boot 3.3.0"}
+	if !reflect.DeepEqual(selected, wantSelected) {
+		t.Errorf("selected items = %v, want %v", selected, wantSelected)
+	}
+	want := map[string]string{
+		command(sectionCommand, int(DEPENDENCIES)):    "Go to Dependencies",
+		command(buttonCommand, int(buttons.DOWNLOAD)): "Download",
+		resetCommand:                           "Reset",
+		command(selectCommand, "3:17"):         "Select Java 17",
+		command(dependencyCommand, "web"):      "Remove dependency Spring Web",
+		command(dependencyCommand, "security"): "Add dependency Spring Security",
+	}
+	for id, name := range want {
+		if names[id] != name {
+			t.Errorf("item %q = %q, want %q", id, names[id], name)
+		}
+	}
+}
+
+func TestPaletteCommands(t *testing.T) {
+	m := newReadyModel(t)
+
+	m = send(m, pick(command(selectCommand, "3:17")), pick(command(sectionCommand, int(METADATA))))
+	if got := m.currentConfig().JavaVersion; got != "17" {
+		t.Errorf("java = %q, want 17", got)
+	}
+	if m.currentSection != METADATA {
+		t.Errorf("section = %v, want METADATA", m.currentSection)
+	}
+
+	m = send(m, pick(resetCommand))
+	if got := m.currentConfig(); !reflect.DeepEqual(got, m.defaults) {
+		t.Errorf("after reset = %+v, want %+v", got, m.defaults)
+	}
+
+	next, cmd := m.Update(pick(command(buttonCommand, int(buttons.SHARE))))
+	m = next.(model)
+	if m.currentSection != BUTTONS || cmd == nil {
+		t.Errorf("the share button wasn't pressed")
+	}
+}
+
+func TestPaletteFromKeys(t *testing.T) {
+	m := newReadyModel(t)
+	next, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	m = next.(model)
+	if cmd == nil {
+		t.Fatal("ctrl+p didn't open the palette")
+	}
+	push, ok := cmd().(overlay.PushMsg)
+	if !ok {
+		t.Fatal("ctrl+p didn't push the palette")
+	}
+	m = send(m, push)
+	m = send(m, keyPresses("s", "e", "c", "u", "r", "i", "t", "y")...)
+
+	next, cmd = m.Update(keyPress("enter"))
+	m = next.(model)
+	if cmd == nil {
+		t.Fatal("enter didn't pick a command")
+	}
+	m = send(m, cmd())
+	if m.dialogs.IsActive() {
+		t.Error("the palette is still open")
+	}
+	if got := m.currentConfig().Dependencies; !reflect.DeepEqual(got, []string{"security"}) {
+		t.Errorf("dependencies = %v, want [security]", got)
+	}
+}
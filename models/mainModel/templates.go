@@ -0,0 +1,26 @@
+package mainModel
+
+import (
+	"github.com/eslam-allam/spring-initializer-go/service/templates"
+)
+
+// applyTemplates renders the global and profile template directories into
+// projectDir, returning a summary of the files added.
+func (m model) applyTemplates(projectDir string) (string, error) {
+	dirs, err := templates.Dirs(m.templateDir, m.profileTemplates)
+	if err != nil || len(dirs) == 0 {
+		return "", err
+	}
+	result, err := templates.Apply(projectDir, m.currentConfig(), m.templateConflict, dirs...)
+	if err != nil {
+		return "", err
+	}
+	return result.String(), nil
+}
+
+// hasTemplates reports whether template directories are set to be rendered
+// into extracted projects.
+func (m model) hasTemplates() bool {
+	dirs, err := templates.Dirs(m.templateDir, m.profileTemplates)
+	return err != nil || len(dirs) > 0
+}
@@ -0,0 +1,150 @@
+package mainModel
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/eslam-allam/spring-initializer-go/models/review"
+)
+
+// wizardStep is a page of the wizard showing one or more sections. The
+// review step has no sections of its own.
+type wizardStep struct {
+	title    string
+	sections []section
+}
+
+var wizardSteps = []wizardStep{
+	{title: "Project", sections: []section{PROJECT}},
+	{title: "Language", sections: []section{LANGUAGE, PACKAGING}},
+	{title: "Versions", sections: []section{SPRING_BOOT, JAVA}},
+	{title: "Metadata", sections: []section{METADATA}},
+	{title: "Dependencies", sections: []section{DEPENDENCIES}},
+	{title: "Review"},
+	{title: "Generate", sections: []section{BUTTONS}},
+}
+
+func (m model) inReview() bool {
+	return m.wizard && len(wizardSteps[m.step].sections) == 0
+}
+
+// wizardNext focuses the next section of the current step, moving on to the
+// next step once the last one is reached.
+func (m *model) wizardNext() {
+	sections := wizardSteps[m.step].sections
+	for i, sec := range sections {
+		if sec == m.currentSection && i < len(sections)-1 {
+			m.currentSection = sections[i+1]
+			return
+		}
+	}
+	if m.step < len(wizardSteps)-1 {
+		m.goToStep(m.step+1, true)
+	}
+}
+
+// wizardBack is the reverse of wizardNext.
+func (m *model) wizardBack() {
+	sections := wizardSteps[m.step].sections
+	for i, sec := range sections {
+		if sec == m.currentSection && i > 0 {
+			m.currentSection = sections[i-1]
+			return
+		}
+	}
+	if m.step > 0 {
+		m.goToStep(m.step-1, false)
+	}
+}
+
+func (m *model) goToStep(step int, forward bool) {
+	m.step = step
+	sections := wizardSteps[step].sections
+	switch {
+	case len(sections) == 0:
+		m.review.SetEntries(m.reviewEntries()...)
+	case forward:
+		m.currentSection = sections[0]
+	default:
+		m.currentSection = sections[len(sections)-1]
+	}
+}
+
+func (m model) reviewEntries() []review.Entry {
+	entries := []review.Entry{
+		{Label: "Project", Value: m.project.GetSelected().Name},
+		{Label: "Language", Value: m.language.GetSelected().Name},
+		{Label: "Packaging", Value: m.packaging.GetSelected().Name},
+		{Label: "Spring Boot", Value: m.springBootVersion.GetSelected().Name},
+		{Label: "Java", Value: m.javaVersion.GetSelected().Name},
+	}
+	for _, value := range m.metadata.GetValues() {
+		entries = append(entries, review.Entry{Label: value.Name, Value: value.Value})
+	}
+	return append(entries,
+		review.Entry{Label: "Dependencies", Value: strings.Join(m.dependencies.SelectedNames(), ", ")},
+		review.Entry{Label: "Target", Value: m.targetDirectory},
+	)
+}
+
+// wizardIndicator lists the steps of the wizard, falling back to the
+// current step alone when they don't fit.
+func (m model) wizardIndicator() string {
+	titles := make([]string, len(wizardSteps))
+	for i, step := range wizardSteps {
+		if i == m.step {
+			titles[i] = m.styles.currentStep.Render(step.title)
+		} else {
+			titles[i] = m.styles.step.Render(step.title)
+		}
+	}
+	indicator := strings.Join(titles, m.styles.step.Render(" › "))
+
+	h, _ := docStyle.GetFrameSize()
+	if lipgloss.Width(indicator) > m.width-h {
+		indicator = fmt.Sprintf("%s %d/%d", m.styles.currentStep.Render(wizardSteps[m.step].title), m.step+1, len(wizardSteps))
+	}
+	return indicator
+}
+
+// wizardSections renders the sections of the current step.
+func (m model) wizardSections(sections [NSECTIONS]string) []string {
+	if m.inReview() {
+		return []string{m.renderSection("Review", m.review.View(), true)}
+	}
+	rendered := make([]string, 0, len(wizardSteps[m.step].sections))
+	for _, sec := range wizardSteps[m.step].sections {
+		rendered = append(rendered, sections[sec])
+	}
+	return rendered
+}
+
+func (m model) renderWizard(sections [NSECTIONS]string) string {
+	blocks := append([]string{m.wizardIndicator()}, m.wizardSections(sections)...)
+	return m.renderMain(lipgloss.JoinVertical(lipgloss.Center, append(blocks, m.help.View(m.keys))...))
+}
+
+// resizeWizard sizes the sections so that every step fills the screen,
+// splitting it between the sections that share a step.
+func (m *model) resizeWizard() {
+	h, v := docStyle.GetFrameSize()
+	hs, vs := sectionStyle.GetFrameSize()
+	cw, ch := m.width-h-hs, m.height-v-vs-2
+
+	m.project.SetSize(cw, ch)
+	m.language.SetSize(cw, ch-vs-1)
+	m.packaging.SetSize(cw, 1)
+	m.springBootVersion.SetSize(cw, (ch-vs)/2)
+	m.javaVersion.SetSize(cw, ch-vs-(ch-vs)/2)
+	m.metadata.SetSize(cw, ch)
+	// The dependency list draws its paginator and filter below its height.
+	m.dependencies.SetSize(cw, ch-2)
+	m.review.SetSize(cw, ch)
+	m.buttons.SetSize(cw, ch)
+
+	m.help.Width = m.width - h
+
+	m.notification.SetSize(cw, ch)
+	m.dialogs.SetSize(cw, ch)
+}
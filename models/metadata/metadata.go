@@ -9,7 +9,8 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/eslam-allam/spring-initializer-go/constants"
+	"github.com/eslam-allam/spring-initializer-go/models/keymap"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
 	"github.com/muesli/reflow/truncate"
 )
 
@@ -58,6 +59,7 @@ func NewField(name, id, defaultVal string, options ...FieldOption) Field {
 
 type Model struct {
 	keys      KeyMap
+	styles    styles
 	fieldKeys InputKeyMap
 	fields    []Field
 	cursor    int
@@ -77,9 +79,26 @@ func (m Model) GetSize() (h, v int) {
 
 type FieldValue struct {
 	Id    string
+	Name  string
 	Value string
 }
 
+// IsTyping reports whether a field is being edited.
+func (m Model) IsTyping() bool {
+	return m.typing
+}
+
+// Blur stops editing the field being typed in, keeping what was typed.
+func (m *Model) Blur() {
+	if !m.typing {
+		return
+	}
+	field := &m.fields[m.cursor]
+	field.input.Blur()
+	field.inputLastValue = field.input.Value()
+	m.typing = false
+}
+
 func (m Model) GetValues() []FieldValue {
 	values := make([]FieldValue, len(m.fields))
 	for i, field := range m.fields {
@@ -89,11 +108,10 @@ func (m Model) GetValues() []FieldValue {
 		}
 		values[i] = FieldValue{
 			Id:    field.id,
+			Name:  strings.TrimSpace(field.name),
 			Value: value,
 		}
 	}
-
-	logger.Printf("MetaData Values: %+v", values)
 	return values
 }
 
@@ -151,6 +169,68 @@ var DefaultInputKeyMap = InputKeyMap{
 	CANCEL: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
 }
 
+func init() {
+	keymap.Register("metadata", &DefaultKeyMap)
+	keymap.Register("metadata-input", &DefaultInputKeyMap)
+}
+
+func (m *Model) updateLinked(fieldIndex int) {
+	for _, index := range m.fields[fieldIndex].updates {
+		linkedField := &m.fields[index]
+		newValues := make([]string, len(linkedField.valueFrom))
+		for i, index := range linkedField.valueFrom {
+			value := m.fields[index].input.Value()
+			if value == "" {
+				value = m.fields[index].defaultValue
+			}
+			newValues[i] = value
+		}
+		newInput := strings.Join(newValues, string(linkedField.concatChar))
+
+		if newInput == linkedField.defaultValue {
+			linkedField.input.Reset()
+			continue
+		}
+
+		linkedField.input.SetValue(newInput)
+		linkedField.inputLastValue = newInput
+	}
+}
+
+// SetValues fills the fields matching the given ids in display order so that
+// linked fields update as if the values were typed. Ids that don't belong to
+// any field are returned.
+func (m *Model) SetValues(values map[string]string) []string {
+	unknown := make([]string, 0)
+	for id := range values {
+		found := false
+		for _, field := range m.fields {
+			if field.id == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, id)
+		}
+	}
+
+	for i := range m.fields {
+		field := &m.fields[i]
+		value, ok := values[field.id]
+		if !ok {
+			continue
+		}
+		if value == field.defaultValue {
+			value = ""
+		}
+		field.input.SetValue(value)
+		field.inputLastValue = value
+		m.updateLinked(i)
+	}
+	return unknown
+}
+
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	var cmd tea.Cmd
 	switch msg := msg.(type) {
@@ -159,9 +239,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			field := &m.fields[m.cursor]
 			switch {
 			case key.Matches(msg, m.fieldKeys.SUBMIT):
-				field.input.Blur()
-				field.inputLastValue = field.input.Value()
-				m.typing = false
+				m.Blur()
 			case key.Matches(msg, m.fieldKeys.CANCEL):
 				field.input.SetValue(field.inputLastValue)
 				field.input.Blur()
@@ -169,26 +247,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			default:
 				field.input, cmd = field.input.Update(msg)
 			}
-			for _, index := range field.updates {
-				linkedField := &m.fields[index]
-				newValues := make([]string, len(linkedField.valueFrom))
-				for i, index := range linkedField.valueFrom {
-					value := m.fields[index].input.Value()
-					if value == "" {
-						value = m.fields[index].defaultValue
-					}
-					newValues[i] = value
-				}
-				newInput := strings.Join(newValues, string(linkedField.concatChar))
-
-				if newInput == linkedField.defaultValue {
-					linkedField.input.Reset()
-					continue
-				}
-
-				linkedField.input.SetValue(newInput)
-				linkedField.inputLastValue = newInput
-			}
+			m.updateLinked(m.cursor)
 		} else {
 			switch {
 			case key.Matches(msg, m.keys.PREV):
@@ -207,11 +266,48 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 				m.typing = true
 			}
 		}
+	case tea.MouseMsg:
+		if m.typing {
+			break
+		}
+		switch {
+		case msg.Button == tea.MouseButtonWheelUp:
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case msg.Button == tea.MouseButtonWheelDown:
+			if m.cursor < len(m.fields)-1 {
+				m.cursor++
+			}
+		case msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionPress:
+			if msg.Y < 0 || msg.Y >= len(m.fields) {
+				break
+			}
+			m.cursor = msg.Y
+			cmd = m.fields[m.cursor].input.Focus()
+			m.typing = true
+		}
 	}
 	return m, cmd
 }
 
-var hoverStyle lipgloss.Style = lipgloss.NewStyle().Foreground(lipgloss.Color(constants.SecondaryColour))
+type styles struct {
+	hover lipgloss.Style
+}
+
+func newStyles(t theme.Theme) styles {
+	return styles{
+		hover: t.Cursor(),
+	}
+}
+
+// SetTheme restyles the fields using the colours of the given theme.
+func (m *Model) SetTheme(t theme.Theme) {
+	m.styles = newStyles(t)
+	for i := range m.fields {
+		m.fields[i].input.PlaceholderStyle = t.Placeholder()
+	}
+}
 
 func (m Model) View() string {
 	s := strings.Builder{}
@@ -221,7 +317,7 @@ func (m Model) View() string {
 		display := field.input.View()
 
 		if i == m.cursor {
-			display = hoverStyle.Render(display)
+			display = m.styles.hover.Render(display)
 		}
 
 		if lipgloss.Width(display) > m.width-1 {
@@ -249,6 +345,7 @@ func New(fields ...Field) Model {
 	return Model{
 		fields:    newFields,
 		keys:      DefaultKeyMap,
+		styles:    newStyles(theme.Default),
 		fieldKeys: DefaultInputKeyMap,
 	}
 }
@@ -0,0 +1,116 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/eslam-allam/spring-initializer-go/models/overlay"
+)
+
+const timeFormat = "15:04:05"
+
+var historyStyle lipgloss.Style = lipgloss.NewStyle().Padding(1).Border(lipgloss.NormalBorder(), true)
+
+type HistoryKeyMap struct {
+	UP    key.Binding
+	DOWN  key.Binding
+	COPY  key.Binding
+	CLOSE key.Binding
+}
+
+func (k HistoryKeyMap) ShortHelp(copyAllowed bool) []key.Binding {
+	keys := []key.Binding{k.CLOSE}
+	if copyAllowed {
+		keys = append(keys, k.COPY)
+	}
+	return keys
+}
+
+func (k HistoryKeyMap) FullHelp(copyAllowed bool) [][]key.Binding {
+	keys := [][]key.Binding{{k.UP, k.DOWN}, {k.CLOSE}}
+	if copyAllowed {
+		keys[1] = append(keys[1], k.COPY)
+	}
+	return keys
+}
+
+var defaultHistoryKeys = HistoryKeyMap{
+	UP:    key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "previous")),
+	DOWN:  key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "next")),
+	COPY:  key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "copy to clipboard")),
+	CLOSE: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "close history")),
+}
+
+// OpenHistory lists the past notifications, the latest first.
+func (m *Model) OpenHistory() {
+	m.showHistory = true
+	m.historyCursor = 0
+	m.copied = false
+}
+
+// historyEntry returns the i-th latest notification.
+func (m Model) historyEntry(i int) entry {
+	return m.history[len(m.history)-1-i]
+}
+
+func (m *Model) moveHistoryCursor(delta int) {
+	m.historyCursor = max(min(m.historyCursor+delta, len(m.history)-1), 0)
+}
+
+func (m Model) updateHistory(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch {
+	case key.Matches(msg, m.historyKeys.UP):
+		m.moveHistoryCursor(-1)
+	case key.Matches(msg, m.historyKeys.DOWN):
+		m.moveHistoryCursor(1)
+	case m.copyAllowed && len(m.history) > 0 && key.Matches(msg, m.historyKeys.COPY):
+		cmd = m.copy(m.historyEntry(m.historyCursor).text())
+	case key.Matches(msg, m.historyKeys.CLOSE):
+		m.showHistory = false
+		m.copied = false
+	}
+	return m, cmd
+}
+
+func (m Model) updateHistoryMouse(msg tea.MouseMsg) (Model, tea.Cmd) {
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		m.moveHistoryCursor(-1)
+	case tea.MouseButtonWheelDown:
+		m.moveHistoryCursor(1)
+	}
+	return m, nil
+}
+
+func (m Model) historyView() string {
+	innerWidth := m.width - historyStyle.GetHorizontalFrameSize()
+	perPage := max(m.height-historyStyle.GetVerticalFrameSize(), 1)
+	start := (m.historyCursor / perPage) * perPage
+	end := min(start+perPage, len(m.history))
+
+	lines := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		e := m.historyEntry(i)
+		title := notificationTextStyle.Foreground(m.color(e.level)).Render(fmt.Sprintf("%-7s", e.title()))
+		message := strings.Join(strings.Fields(e.message), " ")
+		line := fmt.Sprintf("  %s %s %s", e.time.Format(timeFormat), title, message)
+		if i == m.historyCursor {
+			line = fmt.Sprintf("%s %s %s", m.styles.hover.Render("> "+e.time.Format(timeFormat)), title, message)
+		}
+		lines = append(lines, fit(line, innerWidth))
+	}
+	if len(m.history) == 0 {
+		lines = append(lines, "No notifications yet.")
+	}
+
+	title := "Notifications"
+	if m.copied {
+		title = "COPIED"
+	}
+	body := m.styles.history.Render(lipgloss.Place(innerWidth, perPage, lipgloss.Left, lipgloss.Top, strings.Join(lines, "\n")))
+	return overlay.PlaceTitle(title, body, 0, 0, historyStyle.GetHorizontalFrameSize()/2)
+}
@@ -1,20 +1,36 @@
 package notification
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/eslam-allam/spring-initializer-go/constants"
+	"github.com/eslam-allam/spring-initializer-go/models/keymap"
 	"github.com/eslam-allam/spring-initializer-go/models/overlay"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
+	"github.com/muesli/reflow/truncate"
 	"github.com/muesli/reflow/wordwrap"
+	"github.com/muesli/reflow/wrap"
 	"golang.design/x/clipboard"
 )
 
 type NotificationMsg struct {
 	Message string
 	Level   NotificationLevel
+	Options []CopyOption
+	// Details are shown below the message on demand.
+	Details string
+}
+
+// CopyOption is a piece of the notification that can be copied on its own by
+// pressing Key.
+type CopyOption struct {
+	Key   string
+	Label string
+	Text  string
 }
 
 type NotificationLevel int
@@ -25,36 +41,200 @@ const (
 	ERROR
 )
 
+// timeouts lists how long notifications of each level stay on screen.
+// Errors stay until they are dismissed.
+var timeouts = map[NotificationLevel]time.Duration{
+	INFO:    4 * time.Second,
+	WARNING: 10 * time.Second,
+}
+
 const (
-	infoColor    = "#7ae878"
-	warningColor = "#e6db74"
-	errorColor   = "#f84841"
+	// maxStacked is the number of notifications shown at once, the latest
+	// one in full and the older ones below it on a single line.
+	maxStacked  = 3
+	historySize = 100
 )
 
 var (
-	notificationStyle lipgloss.Style = lipgloss.NewStyle().Padding(1).
-				Border(lipgloss.NormalBorder(), true).
-				BorderForeground(lipgloss.Color(constants.HighlightColour))
-	notificationTextStyle = lipgloss.NewStyle()
+	notificationStyle     lipgloss.Style = lipgloss.NewStyle().Padding(1).Border(lipgloss.NormalBorder(), true)
+	stackedStyle          lipgloss.Style = lipgloss.NewStyle().Padding(0, 1).Border(lipgloss.NormalBorder(), true)
+	notificationTextStyle                = lipgloss.NewStyle()
 )
 
+type styles struct {
+	notification lipgloss.Style
+	stacked      lipgloss.Style
+	history      lipgloss.Style
+	hover        lipgloss.Style
+	muted        lipgloss.Style
+	info         lipgloss.Color
+	warning      lipgloss.Color
+	error        lipgloss.Color
+}
+
+func newStyles(t theme.Theme) styles {
+	return styles{
+		notification: t.Focused(notificationStyle),
+		stacked:      t.Unfocused(stackedStyle),
+		history:      t.Focused(historyStyle),
+		hover:        t.Cursor(),
+		muted:        t.Muted(),
+		info:         lipgloss.Color(t.Success),
+		warning:      lipgloss.Color(t.Warning),
+		error:        lipgloss.Color(t.Failure),
+	}
+}
+
+// SetTheme restyles the notification using the colours of the given theme.
+func (m *Model) SetTheme(t theme.Theme) {
+	m.styles = newStyles(t)
+}
+
+// entry is a notification that was received at time.
+type entry struct {
+	id      int
+	message string
+	level   NotificationLevel
+	options []CopyOption
+	details string
+	time    time.Time
+}
+
+// text is what gets copied of the notification.
+func (e entry) text() string {
+	if e.details == "" {
+		return e.message
+	}
+	return e.message + "\n\n" + e.details
+}
+
+func (e entry) title() string {
+	switch e.level {
+	case WARNING:
+		return "WARNING"
+	case ERROR:
+		return "ERROR"
+	default:
+		return "INFO"
+	}
+}
+
+func (m Model) color(level NotificationLevel) lipgloss.Color {
+	switch level {
+	case WARNING:
+		return m.styles.warning
+	case ERROR:
+		return m.styles.error
+	default:
+		return m.styles.info
+	}
+}
+
+// TimeoutMsg dismisses a notification once its timeout runs out.
+type TimeoutMsg struct {
+	id int
+}
+
+// Model shows the notifications waiting to be dismissed, the latest first,
+// and keeps a history of the past ones.
 type Model struct {
-	message     string
-	keys        NotificationKeyMap
-	level       NotificationLevel
-	width       int
-	height      int
-	active      bool
-	copyAllowed bool
-	copied      bool
+	queue         []entry
+	history       []entry
+	keys          NotificationKeyMap
+	historyKeys   HistoryKeyMap
+	styles        styles
+	lastId        int
+	historyCursor int
+	width         int
+	height        int
+	showHistory   bool
+	showDetails   bool
+	copyAllowed   bool
+	copied        bool
 }
 
 func (m Model) IsActive() bool {
-	return m.active
+	return len(m.queue) > 0 || m.showHistory
+}
+
+// Captures reports whether the notification takes every key and click away
+// from what is below it. The history does, as do the notifications that stay
+// until they are read: warnings, errors and those offering something to copy.
+func (m Model) Captures() bool {
+	if m.showHistory {
+		return true
+	}
+	if len(m.queue) == 0 {
+		return false
+	}
+	e := m.current()
+	return e.level != INFO || len(e.options) > 0
+}
+
+// Handles reports whether msg is meant for the notification, which only
+// takes the keys bound to it unless it captures them all.
+func (m Model) Handles(msg tea.KeyMsg) bool {
+	if m.Captures() {
+		return true
+	}
+	return len(m.queue) > 0 && key.Matches(msg, m.keys.DISMISS, m.keys.DETAILS)
+}
+
+func (m Model) current() entry {
+	return m.queue[len(m.queue)-1]
 }
 
-func (m *Model) Activate() {
-	m.active = true
+func (m *Model) dismiss(id int) {
+	for i, e := range m.queue {
+		if e.id == id {
+			m.queue = append(m.queue[:i:i], m.queue[i+1:]...)
+			break
+		}
+	}
+	m.copied = false
+	m.setOptionKeys()
+}
+
+// setOptionKeys binds the copy options of the current notification and
+// hides its details.
+func (m *Model) setOptionKeys() {
+	m.keys.OPTIONS = nil
+	m.showDetails = false
+	m.keys.DETAILS.SetEnabled(len(m.queue) > 0 && m.current().details != "")
+	if len(m.queue) == 0 {
+		return
+	}
+	for _, option := range m.current().options {
+		m.keys.OPTIONS = append(m.keys.OPTIONS, key.NewBinding(key.WithKeys(option.Key), key.WithHelp(option.Key, "copy "+option.Label)))
+	}
+}
+
+// push queues a notification, dismissing it after the timeout of its level
+// unless it offers something to copy.
+func (m *Model) push(msg NotificationMsg) tea.Cmd {
+	m.lastId++
+	e := entry{id: m.lastId, message: msg.Message, level: msg.Level, options: msg.Options, details: msg.Details, time: time.Now()}
+	m.queue = append(m.queue, e)
+	m.history = append(m.history, e)
+	if len(m.history) > historySize {
+		m.history = m.history[1:]
+	}
+	m.copied = false
+	m.setOptionKeys()
+
+	timeout, ok := timeouts[msg.Level]
+	if !ok || len(msg.Options) > 0 {
+		return nil
+	}
+	return tea.Tick(timeout, func(time.Time) tea.Msg {
+		return TimeoutMsg{id: e.id}
+	})
+}
+
+func (m *Model) copy(text string) tea.Cmd {
+	clipboard.Write(clipboard.FmtText, []byte(text))
+	m.copied = true
+	return copyDone
 }
 
 type CopyDone struct{}
@@ -63,36 +243,61 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	var cmd tea.Cmd
 
 	switch msg := msg.(type) {
+	case NotificationMsg:
+		cmd = m.push(msg)
+	case TimeoutMsg:
+		m.dismiss(msg.id)
 	case CopyDone:
 		m.copied = false
+	case tea.MouseMsg:
+		if m.showHistory {
+			return m.updateHistoryMouse(msg)
+		}
+		if len(m.queue) > 0 && msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionPress {
+			m.dismiss(m.current().id)
+		}
 	case tea.KeyMsg:
+		if m.showHistory {
+			return m.updateHistory(msg)
+		}
+		if len(m.queue) == 0 {
+			break
+		}
 		switch {
 		case key.Matches(msg, m.keys.DISMISS):
-			m.active = false
+			m.dismiss(m.current().id)
+		case key.Matches(msg, m.keys.DETAILS):
+			m.showDetails = !m.showDetails
 		case m.copyAllowed && key.Matches(msg, m.keys.COPY):
-			clipboard.Write(clipboard.FmtText, []byte(m.message))
-			m.copied = true
-			cmd = func() tea.Msg {
-				time.Sleep(1 * time.Second)
-				return CopyDone{}
+			cmd = m.copy(m.current().text())
+		case m.copyAllowed:
+			for i, binding := range m.keys.OPTIONS {
+				if key.Matches(msg, binding) {
+					cmd = m.copy(m.current().options[i].Text)
+					break
+				}
 			}
-
 		}
 	}
 	return m, cmd
 }
 
-func (m Model) UpdateMessage(msg NotificationMsg) Model {
-	m.message = msg.Message
-	m.level = msg.Level
-	return m
+func copyDone() tea.Msg {
+	time.Sleep(1 * time.Second)
+	return CopyDone{}
 }
 
 func (m Model) ShortHelp() []key.Binding {
+	if m.showHistory {
+		return m.historyKeys.ShortHelp(m.copyAllowed)
+	}
 	return m.keys.ShortHelp(m.copyAllowed)
 }
 
 func (m Model) FullHelp() [][]key.Binding {
+	if m.showHistory {
+		return m.historyKeys.FullHelp(m.copyAllowed)
+	}
 	return m.keys.FullHelp(m.copyAllowed)
 }
 
@@ -101,64 +306,113 @@ func (m *Model) SetSize(h, v int) {
 	m.height = v
 }
 
+// fit truncates s to width, leaving alone the lines that fit exactly which
+// truncate would cut short to make room for its tail.
+func fit(s string, width int) string {
+	if lipgloss.Width(s) <= width {
+		return s
+	}
+	return truncate.StringWithTail(s, uint(max(width, 0)), "…")
+}
+
+// stackedView shows an older notification on a single line.
+func (m Model) stackedView(e entry, width int) string {
+	textWidth := max(width-stackedStyle.GetHorizontalFrameSize(), 1)
+	message := strings.Join(strings.Fields(e.message), " ")
+	body := m.styles.stacked.Width(width - stackedStyle.GetHorizontalBorderSize()).Render(
+		notificationTextStyle.Foreground(m.color(e.level)).
+			Render(fit(message, textWidth)))
+	x := stackedStyle.GetHorizontalFrameSize() / 2
+	return overlay.PlaceTitle(e.title(), body, 0, 0, x, 0)
+}
+
 func (m Model) View() string {
-	var currentColor string
-	var title string
+	if m.showHistory {
+		return m.historyView()
+	}
+	if len(m.queue) == 0 {
+		return ""
+	}
 
-	switch m.level {
-	case INFO:
-		currentColor = infoColor
-		title = "INFO"
-	case WARNING:
-		currentColor = warningColor
-		title = "WARNING"
-	case ERROR:
-		currentColor = errorColor
-		title = "ERROR"
+	older := make([]entry, 0, maxStacked-1)
+	for i := len(m.queue) - 2; i >= 0 && len(older) < maxStacked-1; i-- {
+		older = append(older, m.queue[i])
+	}
+	hidden := len(m.queue) - 1 - len(older)
+	stackHeight := len(older) * (stackedStyle.GetVerticalFrameSize() + 1)
+	if hidden > 0 {
+		stackHeight++
 	}
 
-	currentNotificationStyle := notificationStyle.Copy()
+	e := m.current()
+	title := e.title()
+	currentNotificationStyle := m.styles.notification.Copy()
 
 	if m.copied {
 		title = "COPIED"
-		currentNotificationStyle.BorderForeground(lipgloss.Color(infoColor))
+		currentNotificationStyle.BorderForeground(m.styles.info)
 	}
 
+	textWidth := m.width - notificationStyle.GetHorizontalFrameSize()
+	text := notificationTextStyle.Foreground(m.color(e.level)).
+		Render(wrap.String(wordwrap.String(e.message, textWidth), textWidth))
+	if m.showDetails {
+		text = lipgloss.JoinVertical(lipgloss.Left, text, "",
+			m.styles.muted.Render(wrap.String(wordwrap.String(e.details, textWidth), textWidth)))
+	}
 	body := currentNotificationStyle.
-		Render(
-			notificationTextStyle.MaxWidth(m.width).MaxHeight(m.height).
-				Foreground(lipgloss.Color(currentColor)).
-				Render(wordwrap.String(m.message, m.width-notificationStyle.GetHorizontalFrameSize())))
+		Render(notificationTextStyle.MaxWidth(m.width).MaxHeight(max(m.height-stackHeight, 1)).Render(text))
 	x := notificationStyle.GetHorizontalFrameSize()/2 + notificationTextStyle.GetHorizontalFrameSize()/2
-	return overlay.PlaceTitle(title, body, 0, 0, x, 0)
+	views := []string{overlay.PlaceTitle(title, body, 0, 0, x, 0)}
+
+	width := lipgloss.Width(body)
+	for _, e := range older {
+		views = append(views, m.stackedView(e, width))
+	}
+	if hidden > 0 {
+		views = append(views, m.styles.muted.Render(fmt.Sprintf("+%d more", hidden)))
+	}
+	return lipgloss.JoinVertical(lipgloss.Right, views...)
 }
 
 type NotificationKeyMap struct {
 	DISMISS key.Binding
+	DETAILS key.Binding
 	COPY    key.Binding
+	OPTIONS []key.Binding
 }
 
 func (k NotificationKeyMap) ShortHelp(copyAllowed bool) []key.Binding {
-	keys := []key.Binding{k.DISMISS}
+	keys := []key.Binding{k.DISMISS, k.DETAILS}
 	if copyAllowed {
 		keys = append(keys, k.COPY)
+		keys = append(keys, k.OPTIONS...)
 	}
 	return keys
 }
 
 func (k NotificationKeyMap) FullHelp(copyAllowed bool) [][]key.Binding {
-	keys := [][]key.Binding{{k.DISMISS}}
+	keys := [][]key.Binding{{k.DISMISS, k.DETAILS}}
 	if copyAllowed {
 		keys[0] = append(keys[0], k.COPY)
+		if len(k.OPTIONS) > 0 {
+			keys = append(keys, k.OPTIONS)
+		}
 	}
 	return keys
 }
 
 var defaultNotificationKeys NotificationKeyMap = NotificationKeyMap{
 	DISMISS: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "dismiss")),
+	DETAILS: key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "toggle details")),
 	COPY:    key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "copy to clipboard")),
 }
 
+func init() {
+	keymap.Register("notification", &defaultNotificationKeys)
+	keymap.Register("notification-history", &defaultHistoryKeys)
+}
+
 func New() Model {
 	copyAllowed := false
 	err := clipboard.Init()
@@ -167,6 +421,8 @@ func New() Model {
 	}
 	return Model{
 		keys:        defaultNotificationKeys,
+		historyKeys: defaultHistoryKeys,
+		styles:      newStyles(theme.Default),
 		copyAllowed: copyAllowed,
 	}
 }
@@ -0,0 +1,176 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
+)
+
+// newTestModel skips the clipboard set up by New.
+func newTestModel() Model {
+	m := Model{
+		keys:        defaultNotificationKeys,
+		historyKeys: defaultHistoryKeys,
+		styles:      newStyles(theme.Default),
+	}
+	m.SetSize(60, 30)
+	return m
+}
+
+func queued(m Model) []string {
+	messages := make([]string, len(m.queue))
+	for i, e := range m.queue {
+		messages[i] = e.message
+	}
+	return messages
+}
+
+func TestTimeouts(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     NotificationMsg
+		timeout bool
+	}{
+		{"info", NotificationMsg{Message: "saved", Level: INFO}, true},
+		{"warning", NotificationMsg{Message: "careful", Level: WARNING}, true},
+		{"error", NotificationMsg{Message: "failed", Level: ERROR}, false},
+		{"copy options", NotificationMsg{Message: "link", Level: INFO, Options: []CopyOption{{Key: "u", Label: "url", Text: "x"}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, cmd := newTestModel().Update(tt.msg)
+			if (cmd != nil) != tt.timeout {
+				t.Errorf("timeout scheduled = %v, want %v", cmd != nil, tt.timeout)
+			}
+		})
+	}
+}
+
+func TestQueue(t *testing.T) {
+	m := newTestModel()
+	for _, message := range []string{"first", "second", "third"} {
+		m, _ = m.Update(NotificationMsg{Message: message, Level: ERROR})
+	}
+	if got := m.current().message; got != "third" {
+		t.Fatalf("current = %q, want the latest", got)
+	}
+
+	// The timeout of an older notification only dismisses that one.
+	m, _ = m.Update(TimeoutMsg{id: 1})
+	if got := strings.Join(queued(m), ","); got != "second,third" {
+		t.Errorf("after timeout queue = %s", got)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if got := strings.Join(queued(m), ","); got != "second" {
+		t.Errorf("after dismiss queue = %s", got)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.IsActive() {
+		t.Error("model should be inactive once every notification is dismissed")
+	}
+	if len(m.history) != 3 {
+		t.Errorf("history holds %d entries, want 3", len(m.history))
+	}
+}
+
+func TestStackedView(t *testing.T) {
+	m := newTestModel()
+	for i := 1; i <= 5; i++ {
+		m, _ = m.Update(NotificationMsg{Message: fmt.Sprintf("message %d", i), Level: WARNING})
+	}
+	view := m.View()
+	for _, want := range []string{"message 5", "message 4", "message 3", "+2 more"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view doesn't show %q:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "message 2") {
+		t.Errorf("view shows more than %d notifications:\n%s", maxStacked, view)
+	}
+}
+
+func TestDetails(t *testing.T) {
+	m := newTestModel()
+	m, _ = m.Update(NotificationMsg{Message: "Generation failed", Level: ERROR, Details: "HTTP 400"})
+	if strings.Contains(m.View(), "HTTP 400") {
+		t.Error("details shown before being asked for")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	if !strings.Contains(m.View(), "HTTP 400") {
+		t.Error("details not shown after toggling them")
+	}
+	if got := m.current().text(); got != "Generation failed\n\nHTTP 400" {
+		t.Errorf("copied text = %q", got)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	m := newTestModel()
+	for i := 1; i <= historySize+5; i++ {
+		m, _ = m.Update(NotificationMsg{Message: fmt.Sprintf("message %d", i), Level: INFO})
+	}
+	if len(m.history) != historySize {
+		t.Fatalf("history holds %d entries, want %d", len(m.history), historySize)
+	}
+	m.OpenHistory()
+	if got := m.historyEntry(0).message; got != fmt.Sprintf("message %d", historySize+5) {
+		t.Errorf("first history entry = %q, want the latest", got)
+	}
+	if got := m.historyEntry(historySize - 1).message; got != "message 6" {
+		t.Errorf("last history entry = %q, want the oldest kept", got)
+	}
+	if !strings.Contains(m.View(), "Notifications") {
+		t.Errorf("history view:\n%s", m.View())
+	}
+}
+
+func TestCaptures(t *testing.T) {
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	esc := tea.KeyMsg{Type: tea.KeyEsc}
+	details := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")}
+	tests := []struct {
+		name     string
+		msg      NotificationMsg
+		captures bool
+		handles  []tea.KeyMsg
+		ignores  []tea.KeyMsg
+	}{
+		{"info", NotificationMsg{Message: "saved", Level: INFO}, false, []tea.KeyMsg{esc}, []tea.KeyMsg{down, details}},
+		{"info with details", NotificationMsg{Message: "saved", Level: INFO, Details: "more"}, false, []tea.KeyMsg{esc, details}, []tea.KeyMsg{down}},
+		{"info with copy options", NotificationMsg{Message: "link", Level: INFO, Options: []CopyOption{{Key: "u", Label: "url", Text: "x"}}}, true, []tea.KeyMsg{down}, nil},
+		{"warning", NotificationMsg{Message: "careful", Level: WARNING}, true, []tea.KeyMsg{down}, nil},
+		{"error", NotificationMsg{Message: "failed", Level: ERROR}, true, []tea.KeyMsg{down}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel()
+			m, _ = m.Update(tt.msg)
+			if got := m.Captures(); got != tt.captures {
+				t.Errorf("Captures() = %v, want %v", got, tt.captures)
+			}
+			for _, k := range tt.handles {
+				if !m.Handles(k) {
+					t.Errorf("Handles(%s) = false", k)
+				}
+			}
+			for _, k := range tt.ignores {
+				if m.Handles(k) {
+					t.Errorf("Handles(%s) = true", k)
+				}
+			}
+		})
+	}
+
+	m := newTestModel()
+	if m.Captures() || m.Handles(esc) {
+		t.Error("captures keys without any notification")
+	}
+	m.OpenHistory()
+	if !m.Captures() {
+		t.Error("the history doesn't capture the keys")
+	}
+}
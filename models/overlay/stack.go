@@ -0,0 +1,127 @@
+package overlay
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Dialog is a model shown above everything else by a Stack, such as a
+// picker, a prompt or a confirmation. It closes itself by no longer being
+// active.
+type Dialog interface {
+	help.KeyMap
+	View() string
+	IsActive() bool
+	// Open activates the dialog as it is pushed.
+	Open() (Dialog, tea.Cmd)
+	// Resize sets the space available to the dialog.
+	Resize(h, v int) Dialog
+	UpdateDialog(msg tea.Msg) (Dialog, tea.Cmd)
+}
+
+// PushMsg opens Dialog on the Stack handling it, letting any sub-model show
+// a dialog through the commands it returns.
+type PushMsg struct {
+	Dialog Dialog
+}
+
+// Push returns a command opening d on top of the dialogs already shown.
+func Push(d Dialog) tea.Cmd {
+	return func() tea.Msg {
+		return PushMsg{Dialog: d}
+	}
+}
+
+// Stack shows dialogs centered above a view. The latest dialog gets the keys
+// and the mouse and is popped once it closes, giving the focus back to the
+// one below it. Any other message reaches every dialog.
+type Stack struct {
+	dialogs []Dialog
+	width   int
+	height  int
+}
+
+func (s Stack) IsActive() bool {
+	return len(s.dialogs) > 0
+}
+
+// Push opens d on top of the dialogs already shown.
+func (s *Stack) Push(d Dialog) tea.Cmd {
+	d, cmd := d.Open()
+	s.dialogs = append(s.dialogs, d.Resize(s.width, s.height))
+	return cmd
+}
+
+// SetSize sets the space available to every dialog.
+func (s *Stack) SetSize(h, v int) {
+	s.width = h
+	s.height = v
+	for i, d := range s.dialogs {
+		s.dialogs[i] = d.Resize(h, v)
+	}
+}
+
+func (s Stack) Update(msg tea.Msg) (Stack, tea.Cmd) {
+	if msg, ok := msg.(PushMsg); ok {
+		cmd := s.Push(msg.Dialog)
+		return s, cmd
+	}
+	if !s.IsActive() {
+		return s, nil
+	}
+
+	switch msg.(type) {
+	case tea.KeyMsg, tea.MouseMsg:
+		top := len(s.dialogs) - 1
+		d, cmd := s.dialogs[top].UpdateDialog(msg)
+		// Copied so that the stack doesn't change under the previous model.
+		s.dialogs = append(s.dialogs[:top:top], d)
+		if !d.IsActive() {
+			s.dialogs = s.dialogs[:top]
+		}
+		return s, cmd
+	}
+
+	dialogs := make([]Dialog, 0, len(s.dialogs))
+	cmds := make([]tea.Cmd, 0, len(s.dialogs))
+	for _, d := range s.dialogs {
+		d, cmd := d.UpdateDialog(msg)
+		if d.IsActive() {
+			dialogs = append(dialogs, d)
+		}
+		cmds = append(cmds, cmd)
+	}
+	s.dialogs = dialogs
+	return s, tea.Batch(cmds...)
+}
+
+func (s Stack) ShortHelp() []key.Binding {
+	if !s.IsActive() {
+		return nil
+	}
+	return s.dialogs[len(s.dialogs)-1].ShortHelp()
+}
+
+func (s Stack) FullHelp() [][]key.Binding {
+	if !s.IsActive() {
+		return nil
+	}
+	return s.dialogs[len(s.dialogs)-1].FullHelp()
+}
+
+// Place draws the dialogs in the middle of background, the latest on top.
+func (s Stack) Place(background string) string {
+	for _, d := range s.dialogs {
+		background = PlaceCenter(d.View(), background)
+	}
+	return background
+}
+
+// PlaceCenter draws fg in the middle of bg.
+func PlaceCenter(fg, bg string) string {
+	w, h := lipgloss.Size(bg)
+	fw, fh := lipgloss.Size(fg)
+	return PlaceOverlay(w/2-fw/2, h/2-fh/2, fg, bg)
+}
@@ -0,0 +1,101 @@
+package overlay
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type testMsg struct{}
+
+// testDialog records the messages it gets, closing on esc.
+type testDialog struct {
+	name   string
+	active bool
+	width  int
+	height int
+	got    []tea.Msg
+}
+
+func (d testDialog) ShortHelp() []key.Binding  { return nil }
+func (d testDialog) FullHelp() [][]key.Binding { return nil }
+func (d testDialog) View() string              { return d.name }
+func (d testDialog) IsActive() bool            { return d.active }
+
+func (d testDialog) Open() (Dialog, tea.Cmd) {
+	d.active = true
+	return d, nil
+}
+
+func (d testDialog) Resize(h, v int) Dialog {
+	d.width, d.height = h, v
+	return d
+}
+
+func (d testDialog) UpdateDialog(msg tea.Msg) (Dialog, tea.Cmd) {
+	d.got = append(d.got, msg)
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.Type == tea.KeyEsc {
+		d.active = false
+	}
+	return d, nil
+}
+
+func (s Stack) names() []string {
+	names := make([]string, len(s.dialogs))
+	for i, d := range s.dialogs {
+		names[i] = d.View()
+	}
+	return names
+}
+
+func TestStack(t *testing.T) {
+	var s Stack
+	s.SetSize(40, 20)
+	s, _ = s.Update(PushMsg{Dialog: testDialog{name: "first"}})
+	s.Push(testDialog{name: "second"})
+	if got, want := s.names(), []string{"first", "second"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("dialogs = %v, want %v", got, want)
+	}
+	if d := s.dialogs[1].(testDialog); !d.active || d.width != 40 || d.height != 20 {
+		t.Errorf("pushed dialog = %+v, want it open and sized 40x20", d)
+	}
+
+	press := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")}
+	mouse := tea.MouseMsg{Button: tea.MouseButtonWheelDown}
+	s, _ = s.Update(press)
+	s, _ = s.Update(mouse)
+	s, _ = s.Update(testMsg{})
+	if got := s.dialogs[0].(testDialog).got; !reflect.DeepEqual(got, []tea.Msg{testMsg{}}) {
+		t.Errorf("first dialog got %v, want only the other message", got)
+	}
+	if got := s.dialogs[1].(testDialog).got; !reflect.DeepEqual(got, []tea.Msg{press, mouse, testMsg{}}) {
+		t.Errorf("top dialog got %v, want the key, the mouse and the other message", got)
+	}
+
+	s, _ = s.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if got, want := s.names(), []string{"first"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("after closing the top dialog = %v, want %v", got, want)
+	}
+	s, _ = s.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if s.IsActive() {
+		t.Errorf("stack still active after closing every dialog")
+	}
+	if got := s.Place("background"); got != "background" {
+		t.Errorf("Place() = %q, want the background alone", got)
+	}
+}
+
+func TestStackDoesNotChangePreviousModel(t *testing.T) {
+	var s Stack
+	s.Push(testDialog{name: "first"})
+	s.Push(testDialog{name: "second"})
+	previous := s
+	s, _ = s.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	s.Push(testDialog{name: "third"})
+
+	if got, want := previous.names(), []string{"first", "second"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("previous dialogs = %v, want %v", got, want)
+	}
+}
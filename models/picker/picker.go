@@ -0,0 +1,272 @@
+package picker
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/eslam-allam/spring-initializer-go/models/keymap"
+	"github.com/eslam-allam/spring-initializer-go/models/overlay"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
+	"github.com/lithammer/fuzzysearch/fuzzy"
+	"github.com/muesli/reflow/truncate"
+)
+
+var pickerStyle lipgloss.Style = lipgloss.NewStyle().Padding(1).Border(lipgloss.NormalBorder(), true)
+
+type styles struct {
+	picker      lipgloss.Style
+	hover       lipgloss.Style
+	description lipgloss.Style
+}
+
+func newStyles(t theme.Theme) styles {
+	return styles{
+		picker:      t.Focused(pickerStyle),
+		hover:       t.Cursor(),
+		description: t.Accent(),
+	}
+}
+
+// SetTheme restyles the picker using the colours of the given theme.
+func (m *Model) SetTheme(t theme.Theme) {
+	m.styles = newStyles(t)
+	m.filterField.PlaceholderStyle = t.Placeholder()
+}
+
+type Item struct {
+	Id          string
+	Name        string
+	Description string
+}
+
+// PickedMsg is sent when an item is chosen from the picker identified by
+// Picker.
+type PickedMsg struct {
+	Picker string
+	Item   Item
+}
+
+type Model struct {
+	id          string
+	title       string
+	keys        KeyMap
+	styles      styles
+	items       []Item
+	filtered    []Item
+	filterField textinput.Model
+	cursor      int
+	width       int
+	height      int
+	active      bool
+}
+
+func (m Model) IsActive() bool {
+	return m.active
+}
+
+func (m *Model) Activate() tea.Cmd {
+	m.active = true
+	m.filterField.Reset()
+	m.filtered = m.items
+	m.cursor = 0
+	return m.filterField.Focus()
+}
+
+func (m *Model) Deactivate() {
+	m.active = false
+	m.filterField.Blur()
+}
+
+// Open, Resize and UpdateDialog let the picker be shown by an overlay.Stack.
+func (m Model) Open() (overlay.Dialog, tea.Cmd) {
+	cmd := m.Activate()
+	return m, cmd
+}
+
+func (m Model) Resize(h, v int) overlay.Dialog {
+	m.SetSize(h, v)
+	return m
+}
+
+func (m Model) UpdateDialog(msg tea.Msg) (overlay.Dialog, tea.Cmd) {
+	return m.Update(msg)
+}
+
+func (m *Model) SetItems(items ...Item) {
+	m.items = items
+	m.filtered = filterItems(items, m.filterField.Value())
+	m.cursor = 0
+}
+
+func (m *Model) SetSize(h, v int) {
+	m.width = h
+	m.height = v
+	m.filterField.Width = h - pickerStyle.GetHorizontalFrameSize() - lipgloss.Width(m.filterField.Prompt) - 1
+}
+
+func (m Model) GetSize() (h, v int) {
+	return m.width, m.height
+}
+
+func (m Model) ShortHelp() []key.Binding {
+	return m.keys.ShortHelp()
+}
+
+func (m Model) FullHelp() [][]key.Binding {
+	return m.keys.FullHelp()
+}
+
+type KeyMap struct {
+	PREV   key.Binding
+	NEXT   key.Binding
+	SELECT key.Binding
+	CANCEL key.Binding
+}
+
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{}
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.PREV, k.NEXT}, {k.SELECT, k.CANCEL}}
+}
+
+var defaultKeys = KeyMap{
+	PREV:   key.NewBinding(key.WithKeys("up", "ctrl+k"), key.WithHelp("↑/ctrl+k", "previous")),
+	NEXT:   key.NewBinding(key.WithKeys("down", "ctrl+j"), key.WithHelp("↓/ctrl+j", "next")),
+	SELECT: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+	CANCEL: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+}
+
+func init() {
+	keymap.Register("picker", &defaultKeys)
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.PREV):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.NEXT):
+			if m.cursor < len(m.filtered)-1 {
+				m.cursor++
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.SELECT):
+			if len(m.filtered) == 0 {
+				return m, nil
+			}
+			picked := PickedMsg{Picker: m.id, Item: m.filtered[m.cursor]}
+			m.Deactivate()
+			return m, func() tea.Msg {
+				return picked
+			}
+		case key.Matches(msg, m.keys.CANCEL):
+			m.Deactivate()
+			return m, nil
+		}
+
+		previous := m.filterField.Value()
+		m.filterField, cmd = m.filterField.Update(msg)
+		if m.filterField.Value() != previous {
+			m.filtered = filterItems(m.items, m.filterField.Value())
+			m.cursor = 0
+		}
+
+	case tea.MouseMsg:
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case tea.MouseButtonWheelDown:
+			if m.cursor < len(m.filtered)-1 {
+				m.cursor++
+			}
+		}
+
+	default:
+		// Keeps the cursor of the filter blinking.
+		m.filterField, cmd = m.filterField.Update(msg)
+	}
+	return m, cmd
+}
+
+func filterItems(items []Item, value string) []Item {
+	if value == "" {
+		return items
+	}
+	filtered := make([]Item, 0)
+	for _, item := range items {
+		if fuzzy.MatchFold(value, item.Name) || fuzzy.MatchFold(value, item.Description) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+func (m Model) View() string {
+	innerWidth := m.width - pickerStyle.GetHorizontalFrameSize()
+	perPage := m.height - pickerStyle.GetVerticalFrameSize() - 2
+	if perPage < 1 {
+		perPage = 1
+	}
+	start := (m.cursor / perPage) * perPage
+	end := min(start+perPage, len(m.filtered))
+
+	s := strings.Builder{}
+	for i, item := range m.filtered[start:end] {
+		display := item.Name
+		if item.Description != "" {
+			display += " " + m.styles.description.Render(item.Description)
+		}
+		if start+i == m.cursor {
+			display = m.styles.hover.Render("> " + item.Name)
+			if item.Description != "" {
+				display += " " + m.styles.description.Render(item.Description)
+			}
+		} else {
+			display = "  " + display
+		}
+		if lipgloss.Width(display) > innerWidth {
+			display = truncate.StringWithTail(display, uint(innerWidth), "…")
+		}
+		s.WriteString(display)
+		if i < end-start-1 {
+			s.WriteString("\n")
+		}
+	}
+	if len(m.filtered) == 0 {
+		s.WriteString("  No matches")
+	}
+
+	filter := m.filterField.View()
+	if lipgloss.Width(filter) > innerWidth {
+		filter = truncate.StringWithTail(filter, uint(innerWidth), "…")
+	}
+	body := m.styles.picker.Render(lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.Place(innerWidth, perPage, lipgloss.Left, lipgloss.Top, s.String()), "", filter))
+	return overlay.PlaceTitle(m.title, body, 0, 0, pickerStyle.GetHorizontalFrameSize()/2)
+}
+
+func New(id, title string, items ...Item) Model {
+	filterField := textinput.New()
+	filterField.Placeholder = "Type here to filter..."
+	return Model{
+		id:          id,
+		title:       title,
+		keys:        defaultKeys,
+		styles:      newStyles(theme.Default),
+		items:       items,
+		filtered:    items,
+		filterField: filterField,
+	}
+}
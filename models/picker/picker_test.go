@@ -0,0 +1,96 @@
+package picker
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newTestPicker() Model {
+	m := New("profiles", "Profiles")
+	m.SetItems(
+		Item{Id: "api", Name: "api", Description: "Spring Web, JPA"},
+		Item{Id: "batch", Name: "batch", Description: "Spring Batch"},
+		Item{Id: "cli", Name: "cli", Description: "Spring Shell"},
+	)
+	m.SetSize(40, 12)
+	d, _ := m.Open()
+	return d.(Model)
+}
+
+func typeText(m Model, s string) Model {
+	for _, r := range s {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
+func picked(t *testing.T, cmd tea.Cmd) Item {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("nothing picked")
+	}
+	msg, ok := cmd().(PickedMsg)
+	if !ok || msg.Picker != "profiles" {
+		t.Fatalf("picked %#v", msg)
+	}
+	return msg.Item
+}
+
+func TestPick(t *testing.T) {
+	m := newTestPicker()
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if item := picked(t, cmd); item.Id != "cli" {
+		t.Errorf("picked %q, want cli", item.Id)
+	}
+	if m.IsActive() {
+		t.Error("the picker is still active once an item is picked")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	m := newTestPicker()
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	// Matches the description of batch alone.
+	m = typeText(m, "Batch")
+	if len(m.filtered) != 1 || m.cursor != 0 {
+		t.Fatalf("filtered = %v, cursor = %d", m.filtered, m.cursor)
+	}
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if item := picked(t, cmd); item.Id != "batch" {
+		t.Errorf("picked %q, want batch", item.Id)
+	}
+
+	m = typeText(m, "zzz")
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil || !m.IsActive() {
+		t.Error("enter without any match closed the picker")
+	}
+
+	// Opening again clears the filter.
+	d, _ := m.Open()
+	if m = d.(Model); len(m.filtered) != 3 {
+		t.Errorf("filtered = %v after opening again, want every item", m.filtered)
+	}
+}
+
+func TestWheelAndCancel(t *testing.T) {
+	m := newTestPicker()
+	m, _ = m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelUp})
+	m, _ = m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+	m, _ = m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+	if m.cursor != 2 {
+		t.Errorf("cursor = %d, want 2", m.cursor)
+	}
+	m, _ = m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+	if m.cursor != 2 {
+		t.Errorf("cursor = %d past the last item", m.cursor)
+	}
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd != nil || m.IsActive() {
+		t.Error("esc didn't close the picker without picking")
+	}
+}
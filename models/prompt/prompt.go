@@ -0,0 +1,158 @@
+package prompt
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/eslam-allam/spring-initializer-go/models/keymap"
+	"github.com/eslam-allam/spring-initializer-go/models/overlay"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
+	"github.com/muesli/reflow/truncate"
+)
+
+var promptStyle lipgloss.Style = lipgloss.NewStyle().Padding(1).Border(lipgloss.NormalBorder(), true)
+
+type styles struct {
+	prompt lipgloss.Style
+}
+
+func newStyles(t theme.Theme) styles {
+	return styles{
+		prompt: t.Focused(promptStyle),
+	}
+}
+
+// SetTheme restyles the prompt using the colours of the given theme.
+func (m *Model) SetTheme(t theme.Theme) {
+	m.styles = newStyles(t)
+	m.input.PlaceholderStyle = t.Placeholder()
+}
+
+// SubmitMsg is sent when a value is submitted in the prompt identified by
+// Prompt.
+type SubmitMsg struct {
+	Prompt string
+	Value  string
+}
+
+type Model struct {
+	id     string
+	title  string
+	keys   KeyMap
+	styles styles
+	input  textinput.Model
+	width  int
+	active bool
+}
+
+func (m Model) IsActive() bool {
+	return m.active
+}
+
+func (m *Model) Activate() tea.Cmd {
+	m.active = true
+	m.input.Reset()
+	return m.input.Focus()
+}
+
+func (m *Model) Deactivate() {
+	m.active = false
+	m.input.Blur()
+}
+
+// Open, Resize and UpdateDialog let the prompt be shown by an overlay.Stack.
+func (m Model) Open() (overlay.Dialog, tea.Cmd) {
+	cmd := m.Activate()
+	return m, cmd
+}
+
+func (m Model) Resize(h, v int) overlay.Dialog {
+	m.SetSize(h, v)
+	return m
+}
+
+func (m Model) UpdateDialog(msg tea.Msg) (overlay.Dialog, tea.Cmd) {
+	return m.Update(msg)
+}
+
+func (m *Model) SetSize(h, v int) {
+	m.width = h
+	m.input.Width = h - promptStyle.GetHorizontalFrameSize() - lipgloss.Width(m.input.Prompt) - 1
+}
+
+func (m Model) ShortHelp() []key.Binding {
+	return m.keys.ShortHelp()
+}
+
+func (m Model) FullHelp() [][]key.Binding {
+	return m.keys.FullHelp()
+}
+
+type KeyMap struct {
+	SUBMIT key.Binding
+	CANCEL key.Binding
+}
+
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{}
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.SUBMIT, k.CANCEL}}
+}
+
+var defaultKeys = KeyMap{
+	SUBMIT: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
+	CANCEL: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+}
+
+func init() {
+	keymap.Register("prompt", &defaultKeys)
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.SUBMIT):
+			submitted := SubmitMsg{Prompt: m.id, Value: m.input.Value()}
+			m.Deactivate()
+			return m, func() tea.Msg {
+				return submitted
+			}
+		case key.Matches(msg, m.keys.CANCEL):
+			m.Deactivate()
+			return m, nil
+		}
+		m.input, cmd = m.input.Update(msg)
+
+	default:
+		// Keeps the cursor of the input blinking.
+		m.input, cmd = m.input.Update(msg)
+	}
+	return m, cmd
+}
+
+func (m Model) View() string {
+	innerWidth := m.width - promptStyle.GetHorizontalFrameSize()
+	input := m.input.View()
+	if lipgloss.Width(input) > innerWidth {
+		input = truncate.StringWithTail(input, uint(innerWidth), "…")
+	}
+	body := m.styles.prompt.Render(lipgloss.PlaceHorizontal(innerWidth, lipgloss.Left, input))
+	return overlay.PlaceTitle(m.title, body, 0, 0, promptStyle.GetHorizontalFrameSize()/2)
+}
+
+func New(id, title, placeholder string) Model {
+	input := textinput.New()
+	input.Placeholder = placeholder
+	return Model{
+		id:     id,
+		title:  title,
+		keys:   defaultKeys,
+		styles: newStyles(theme.Default),
+		input:  input,
+	}
+}
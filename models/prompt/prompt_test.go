@@ -0,0 +1,56 @@
+package prompt
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newTestPrompt() (Model, tea.Cmd) {
+	m := New("save", "Save Profile", "Profile name...")
+	m.SetSize(40, 10)
+	d, cmd := m.Open()
+	return d.(Model), cmd
+}
+
+func TestSubmit(t *testing.T) {
+	m, _ := newTestPrompt()
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("api")})
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.IsActive() {
+		t.Error("the prompt is still active once submitted")
+	}
+	if cmd == nil {
+		t.Fatal("nothing submitted")
+	}
+	if got, want := cmd(), (SubmitMsg{Prompt: "save", Value: "api"}); got != want {
+		t.Errorf("submitted %#v, want %#v", got, want)
+	}
+
+	// Opening again starts from an empty value.
+	d, _ := m.Open()
+	if m = d.(Model); m.input.Value() != "" {
+		t.Errorf("value = %q after opening again", m.input.Value())
+	}
+}
+
+func TestCancel(t *testing.T) {
+	m, _ := newTestPrompt()
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("api")})
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd != nil || m.IsActive() {
+		t.Error("esc didn't close the prompt without submitting")
+	}
+}
+
+func TestBlink(t *testing.T) {
+	m, cmd := newTestPrompt()
+	if cmd == nil {
+		t.Fatal("opening the prompt doesn't start the cursor blinking")
+	}
+	// Waits for the first blink.
+	_, cmd = m.Update(cmd())
+	if cmd == nil {
+		t.Error("the prompt doesn't keep its cursor blinking")
+	}
+}
@@ -7,7 +7,8 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/eslam-allam/spring-initializer-go/constants"
+	"github.com/eslam-allam/spring-initializer-go/models/keymap"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
 	"github.com/muesli/reflow/truncate"
 )
 
@@ -19,8 +20,14 @@ type Item struct {
 	Action string
 }
 
+// SelectMsg selects the choice matching Id, see Select.
+type SelectMsg struct {
+	Id string
+}
+
 type Model struct {
 	keys      KeyMap
+	styles    styles
 	choices   []Item
 	cursor    int
 	selected  int
@@ -38,10 +45,28 @@ func (m Model) GetSize() (h, v int) {
 	return m.width, m.height
 }
 
+// Choices returns every item of the list.
+func (m Model) Choices() []Item {
+	return m.choices
+}
+
 func (m Model) GetSelected() Item {
 	return m.choices[m.selected]
 }
 
+// Select moves the selection and cursor to the item matching the given id or
+// name, reporting whether such an item exists.
+func (m *Model) Select(id string) bool {
+	for i, choice := range m.choices {
+		if choice.Id == id || choice.Name == id {
+			m.selected = i
+			m.cursor = i
+			return true
+		}
+	}
+	return false
+}
+
 func (m Model) ShortHelp() []key.Binding {
 	return m.keys.ShortHelp()
 }
@@ -70,8 +95,24 @@ var defaultKeys = KeyMap{
 	SELECT: key.NewBinding(key.WithKeys("enter", " "), key.WithHelp("enter/space", "select")),
 }
 
+// horizontalKeys replace PREV and NEXT of lists laid out horizontally.
+var horizontalKeys = struct {
+	PREV key.Binding
+	NEXT key.Binding
+}{
+	PREV: key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "prev")),
+	NEXT: key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "next")),
+}
+
+func init() {
+	keymap.Register("list", &defaultKeys)
+	keymap.Register("horizontal-list", &horizontalKeys)
+}
+
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case SelectMsg:
+		m.Select(msg.Id)
 	case tea.KeyMsg:
 		switch {
 		case key.Matches(msg, m.keys.PREV):
@@ -85,14 +126,53 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		case key.Matches(msg, m.keys.SELECT):
 			m.selected = m.cursor
 		}
+	case tea.MouseMsg:
+		switch {
+		case msg.Button == tea.MouseButtonWheelUp:
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case msg.Button == tea.MouseButtonWheelDown:
+			if m.cursor < len(m.choices)-1 {
+				m.cursor++
+			}
+		case msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionPress:
+			if index, ok := m.choiceAt(msg.X, msg.Y); ok {
+				m.cursor = index
+				m.selected = index
+			}
+		}
 	}
 	return m, nil
 }
 
-var hoverStyle lipgloss.Style = lipgloss.NewStyle().Foreground(lipgloss.Color(constants.SecondaryColour))
+// choiceAt returns the choice rendered at the given position of the view.
+func (m Model) choiceAt(x, y int) (int, bool) {
+	startIndex, lastIndex := m.bounds()
+	if m.direction == VERTICAL {
+		index := startIndex + y
+		return index, y >= 0 && index < lastIndex
+	}
 
-func (m Model) View() string {
-	s := strings.Builder{}
+	if y != 0 {
+		return 0, false
+	}
+	offset := 0
+	for i, choice := range m.choices {
+		width := min(lipgloss.Width(choice.Name), m.width-4) + 4
+		if x >= offset && x < offset+width {
+			return i, true
+		}
+		offset += width + 1
+	}
+	return 0, false
+}
+
+// bounds returns the range of choices shown on the current page.
+func (m Model) bounds() (startIndex, lastIndex int) {
+	if m.direction == HORIZONTAL {
+		return 0, len(m.choices)
+	}
 
 	perPage := m.height
 	if perPage < 1 {
@@ -100,17 +180,34 @@ func (m Model) View() string {
 	}
 	currentPage := m.cursor / perPage
 
-	startIndex := currentPage * perPage
-	lastIndex := startIndex + perPage
+	startIndex = currentPage * perPage
+	lastIndex = startIndex + perPage
 
 	if lastIndex > len(m.choices) {
 		lastIndex = len(m.choices)
 	}
+	return startIndex, lastIndex
+}
 
-	if m.direction == HORIZONTAL {
-		startIndex = 0
-		lastIndex = len(m.choices)
+type styles struct {
+	hover lipgloss.Style
+}
+
+func newStyles(t theme.Theme) styles {
+	return styles{
+		hover: t.Cursor(),
 	}
+}
+
+// SetTheme restyles the list using the colours of the given theme.
+func (m *Model) SetTheme(t theme.Theme) {
+	m.styles = newStyles(t)
+}
+
+func (m Model) View() string {
+	s := strings.Builder{}
+
+	startIndex, lastIndex := m.bounds()
 
 	for i, choice := range m.choices[startIndex:lastIndex] {
 		currentIndex := startIndex + i
@@ -123,7 +220,7 @@ func (m Model) View() string {
 
 		choiceDisplay := choice.Name
 		if m.cursor == currentIndex {
-			choiceDisplay = hoverStyle.Render(choice.Name)
+			choiceDisplay = m.styles.hover.Render(choice.Name)
 		}
 
 		if lipgloss.Width(choiceDisplay) > m.width-4 {
@@ -151,12 +248,13 @@ const (
 func New(d direction, choices ...Item) Model {
 	keys := defaultKeys
 	if d == HORIZONTAL {
-		keys.PREV = key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "prev"))
-		keys.NEXT = key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "next"))
+		keys.PREV = horizontalKeys.PREV
+		keys.NEXT = horizontalKeys.NEXT
 	}
 	return Model{
 		choices:   choices,
 		keys:      keys,
+		styles:    newStyles(theme.Default),
 		direction: d,
 		height:    3,
 	}
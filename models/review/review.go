@@ -0,0 +1,145 @@
+package review
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/eslam-allam/spring-initializer-go/models/keymap"
+	"github.com/eslam-allam/spring-initializer-go/models/theme"
+	"github.com/muesli/reflow/wordwrap"
+	"github.com/muesli/reflow/wrap"
+)
+
+var emptyStyle lipgloss.Style = lipgloss.NewStyle().Faint(true)
+
+type styles struct {
+	label lipgloss.Style
+}
+
+func newStyles(t theme.Theme) styles {
+	return styles{
+		label: t.Accent().Bold(true),
+	}
+}
+
+// Entry is a single labelled choice shown in the review.
+type Entry struct {
+	Label string
+	Value string
+}
+
+type Model struct {
+	keys     KeyMap
+	styles   styles
+	entries  []Entry
+	viewport viewport.Model
+	width    int
+	height   int
+}
+
+func (m *Model) SetSize(h, v int) {
+	m.width = h
+	m.height = v
+	m.viewport.Width = h
+	m.viewport.Height = v
+	m.render()
+}
+
+func (m Model) GetSize() (h, v int) {
+	return m.width, m.height
+}
+
+// SetTheme restyles the review using the colours of the given theme.
+func (m *Model) SetTheme(t theme.Theme) {
+	m.styles = newStyles(t)
+	m.render()
+}
+
+// SetEntries replaces the reviewed choices and scrolls back to the top.
+func (m *Model) SetEntries(entries ...Entry) {
+	m.entries = entries
+	m.render()
+	m.viewport.GotoTop()
+}
+
+func (m *Model) render() {
+	labelWidth := 0
+	for _, entry := range m.entries {
+		labelWidth = max(labelWidth, lipgloss.Width(entry.Label))
+	}
+	valueWidth := max(m.width-labelWidth-2, 1)
+
+	lines := make([]string, 0, len(m.entries))
+	for _, entry := range m.entries {
+		value := entry.Value
+		if value == "" {
+			value = emptyStyle.Render("none")
+		}
+		value = wrap.String(wordwrap.String(value, valueWidth), valueWidth)
+		label := m.styles.label.Copy().Width(labelWidth + 2).Render(entry.Label)
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top, label, value))
+	}
+	m.viewport.SetContent(strings.Join(lines, "\n"))
+}
+
+func (m Model) ShortHelp() []key.Binding {
+	return m.keys.ShortHelp()
+}
+
+func (m Model) FullHelp() [][]key.Binding {
+	return m.keys.FullHelp()
+}
+
+type KeyMap struct {
+	UP   key.Binding
+	DOWN key.Binding
+}
+
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{}
+}
+
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.UP, k.DOWN}}
+}
+
+var defaultKeys = KeyMap{
+	UP:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "scroll up")),
+	DOWN: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "scroll down")),
+}
+
+func init() {
+	keymap.Register("review", &defaultKeys)
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.UP):
+			m.viewport.LineUp(1)
+		case key.Matches(msg, m.keys.DOWN):
+			m.viewport.LineDown(1)
+		}
+	case tea.MouseMsg:
+		var cmd tea.Cmd
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+	}
+	return m, nil
+}
+
+func (m Model) View() string {
+	return lipgloss.Place(m.width, m.height, lipgloss.Left, lipgloss.Top, m.viewport.View())
+}
+
+func New() Model {
+	return Model{
+		keys:     defaultKeys,
+		styles:   newStyles(theme.Default),
+		viewport: viewport.New(0, 0),
+	}
+}
@@ -0,0 +1,81 @@
+package theme
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// IsMonochrome reports whether the theme relies on text attributes such as
+// bold and underline instead of colours.
+func (t Theme) IsMonochrome() bool {
+	return t == Monochrome
+}
+
+// Cursor styles the item under the cursor.
+func (t Theme) Cursor() lipgloss.Style {
+	if t.IsMonochrome() {
+		return lipgloss.NewStyle().Bold(true).Underline(true)
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(t.Secondary))
+}
+
+// Accent styles titles and descriptions.
+func (t Theme) Accent() lipgloss.Style {
+	if t.IsMonochrome() {
+		return lipgloss.NewStyle()
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(t.Highlight))
+}
+
+// Muted styles things that are out of focus.
+func (t Theme) Muted() lipgloss.Style {
+	if t.IsMonochrome() {
+		return lipgloss.NewStyle().Faint(true)
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(t.Main))
+}
+
+// Focused marks the border of the given style as focused, making it thicker
+// when there are no colours to tell it apart.
+func (t Theme) Focused(s lipgloss.Style) lipgloss.Style {
+	if t.IsMonochrome() {
+		return s.Copy().BorderStyle(lipgloss.ThickBorder())
+	}
+	return s.Copy().BorderForeground(lipgloss.Color(t.Highlight))
+}
+
+// Unfocused marks the border of the given style as out of focus.
+func (t Theme) Unfocused(s lipgloss.Style) lipgloss.Style {
+	if t.IsMonochrome() {
+		return s.Copy()
+	}
+	return s.Copy().BorderForeground(lipgloss.Color(t.Main))
+}
+
+// Placeholder styles the placeholder of text inputs.
+func (t Theme) Placeholder() lipgloss.Style {
+	if t.IsMonochrome() {
+		return lipgloss.NewStyle().Faint(true)
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(t.Hint))
+}
+
+// Help returns the styles of the key binding help.
+func (t Theme) Help() help.Styles {
+	styles := help.New().Styles
+	if !t.IsMonochrome() {
+		return styles
+	}
+	key := lipgloss.NewStyle().Bold(true)
+	desc := lipgloss.NewStyle()
+	sep := lipgloss.NewStyle().Faint(true)
+	return help.Styles{
+		Ellipsis:       sep,
+		ShortKey:       key,
+		ShortDesc:      desc,
+		ShortSeparator: sep,
+		FullKey:        key,
+		FullDesc:       desc,
+		FullSeparator:  sep,
+	}
+}
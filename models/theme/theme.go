@@ -0,0 +1,197 @@
+package theme
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/eslam-allam/spring-initializer-go/service/config"
+)
+
+const (
+	themesDirName = "themes"
+	themeFileExt  = ".yaml"
+)
+
+// Theme holds every colour used by the UI. Colours are hex strings such as
+// "#7ae878".
+type Theme struct {
+	Name string `yaml:"name"`
+	// Base is the built-in theme providing the colours a custom theme leaves
+	// out. Defaults to the dark theme.
+	Base       string `yaml:"base,omitempty"`
+	Foreground string `yaml:"foreground,omitempty"`
+	Background string `yaml:"background,omitempty"`
+	// Main colours the borders of unfocused sections.
+	Main string `yaml:"main,omitempty"`
+	// Highlight colours focused borders, titles and descriptions.
+	Highlight string `yaml:"highlight,omitempty"`
+	// Secondary colours the item under the cursor.
+	Secondary string `yaml:"secondary,omitempty"`
+	Success   string `yaml:"success,omitempty"`
+	Warning   string `yaml:"warning,omitempty"`
+	Failure   string `yaml:"failure,omitempty"`
+	// Hint colours the placeholders of empty text inputs.
+	Hint string `yaml:"hint,omitempty"`
+}
+
+var (
+	Dark = Theme{
+		Name:       "dark",
+		Foreground: "#adbac7",
+		Background: "#1a1b26",
+		Main:       "#495867",
+		Highlight:  "#BAACBD",
+		Secondary:  "#7ae878",
+		Success:    "#7ae878",
+		Warning:    "#e6db74",
+		Failure:    "#f84841",
+		Hint:       "#636e7b",
+	}
+	Light = Theme{
+		Name:       "light",
+		Foreground: "#24292f",
+		Background: "#f6f8fa",
+		Main:       "#8c959f",
+		Highlight:  "#6e4a7e",
+		Secondary:  "#1a7f37",
+		Success:    "#1a7f37",
+		Warning:    "#9a6700",
+		Failure:    "#cf222e",
+		Hint:       "#6e7781",
+	}
+	HighContrast = Theme{
+		Name:       "high-contrast",
+		Foreground: "#ffffff",
+		Background: "#000000",
+		Main:       "#ffffff",
+		Highlight:  "#ffff00",
+		Secondary:  "#00ffff",
+		Success:    "#00ff00",
+		Warning:    "#ffff00",
+		Failure:    "#ff0000",
+		Hint:       "#c0c0c0",
+	}
+	Solarized = Theme{
+		Name:       "solarized",
+		Foreground: "#839496",
+		Background: "#002b36",
+		Main:       "#586e75",
+		Highlight:  "#93a1a1",
+		Secondary:  "#859900",
+		Success:    "#859900",
+		Warning:    "#b58900",
+		Failure:    "#dc322f",
+		Hint:       "#657b83",
+	}
+	// Monochrome has no colours at all, see IsMonochrome.
+	Monochrome = Theme{Name: "monochrome"}
+)
+
+// Default is the theme used when none is configured.
+var Default = Dark
+
+var builtins = []Theme{Dark, Light, HighContrast, Solarized, Monochrome}
+
+// Detect picks the dark or the light theme to match the terminal's
+// background.
+func Detect() Theme {
+	if lipgloss.HasDarkBackground() {
+		return Dark
+	}
+	return Light
+}
+
+func builtin(name string) (Theme, bool) {
+	for _, t := range builtins {
+		if t.Name == strings.ToLower(name) {
+			return t, true
+		}
+	}
+	return Theme{}, false
+}
+
+// withBase fills the colours missing from a custom theme using its base.
+func (t Theme) withBase() (Theme, error) {
+	base := Default
+	if t.Base != "" {
+		b, ok := builtin(t.Base)
+		if !ok {
+			return t, fmt.Errorf("theme %q is based on unknown theme %q", t.Name, t.Base)
+		}
+		base = b
+	}
+	fields := []struct{ value, fallback *string }{
+		{&t.Foreground, &base.Foreground},
+		{&t.Background, &base.Background},
+		{&t.Main, &base.Main},
+		{&t.Highlight, &base.Highlight},
+		{&t.Secondary, &base.Secondary},
+		{&t.Success, &base.Success},
+		{&t.Warning, &base.Warning},
+		{&t.Failure, &base.Failure},
+		{&t.Hint, &base.Hint},
+	}
+	for _, f := range fields {
+		if *f.value == "" {
+			*f.value = *f.fallback
+		}
+	}
+	return t, nil
+}
+
+// Names returns the built-in themes followed by the custom ones found in the
+// themes folder of the config directory.
+func Names() ([]string, error) {
+	names := make([]string, 0, len(builtins))
+	for _, t := range builtins {
+		names = append(names, t.Name)
+	}
+	dir, err := config.SubDir(themesDirName)
+	if err != nil {
+		return names, err
+	}
+	matches, err := filepath.Glob(filepath.Join(dir, "*"+themeFileExt))
+	if err != nil {
+		return names, err
+	}
+	custom := make([]string, 0, len(matches))
+	for _, match := range matches {
+		custom = append(custom, strings.TrimSuffix(filepath.Base(match), themeFileExt))
+	}
+	sort.Strings(custom)
+	return append(names, custom...), nil
+}
+
+// Load returns the theme with the given name, looking for a custom theme
+// file before falling back to the built-in themes.
+func Load(name string) (Theme, error) {
+	if name == "" {
+		return Default, nil
+	}
+	dir, err := config.SubDir(themesDirName)
+	if err != nil {
+		return Default, err
+	}
+
+	var t Theme
+	err = config.ReadYaml(filepath.Join(dir, name+themeFileExt), &t)
+	if errors.Is(err, os.ErrNotExist) {
+		if b, ok := builtin(name); ok {
+			return b, nil
+		}
+		names, _ := Names()
+		return Default, fmt.Errorf("theme %q does not exist (available: %s)", name, strings.Join(names, ", "))
+	}
+	if err != nil {
+		return Default, err
+	}
+	if t.Name == "" {
+		t.Name = name
+	}
+	return t.withBase()
+}
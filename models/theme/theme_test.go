@@ -0,0 +1,34 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestWithBaseFillsMissingColours(t *testing.T) {
+	custom, err := Theme{Name: "mine", Base: "light", Highlight: "#123456"}.withBase()
+	if err != nil {
+		t.Fatalf("withBase: %v", err)
+	}
+	if custom.Highlight != "#123456" {
+		t.Errorf("Highlight = %q, want the custom colour", custom.Highlight)
+	}
+	if custom.Hint != Light.Hint {
+		t.Errorf("Hint = %q, want %q from the base", custom.Hint, Light.Hint)
+	}
+}
+
+func TestWithBaseUnknownBase(t *testing.T) {
+	if _, err := (Theme{Name: "mine", Base: "nope"}).withBase(); err == nil {
+		t.Error("expected an error for an unknown base theme")
+	}
+}
+
+func TestPlaceholderUsesThemeColour(t *testing.T) {
+	for _, th := range []Theme{Dark, Light, HighContrast, Solarized} {
+		if got := th.Placeholder().GetForeground(); got != lipgloss.Color(th.Hint) {
+			t.Errorf("%s placeholder colour = %v, want %s", th.Name, got, th.Hint)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/eslam-allam/spring-initializer-go/constants"
+	"gopkg.in/yaml.v3"
+)
+
+// Dir returns the directory holding the user's configuration, creating it
+// if it doesn't exist yet.
+func Dir() (string, error) {
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get config directory: %v", err)
+	}
+	dir := filepath.Join(base, constants.ConfigDirName)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", fmt.Errorf("failed to create config directory: %v", err)
+	}
+	return dir, nil
+}
+
+// SubDir returns a directory nested inside the config directory, creating
+// it if it doesn't exist yet.
+func SubDir(name string) (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	dir = filepath.Join(dir, name)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", fmt.Errorf("failed to create %s directory: %v", name, err)
+	}
+	return dir, nil
+}
+
+func ReadYaml(fullPath string, v any) error {
+	data, err := os.ReadFile(fullPath)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to parse %s: %v", fullPath, err)
+	}
+	return nil
+}
+
+func WriteYaml(fullPath string, v any) error {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fullPath, data, 0o644)
+}
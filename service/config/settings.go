@@ -0,0 +1,118 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/eslam-allam/spring-initializer-go/service/hooks"
+	"gopkg.in/yaml.v3"
+)
+
+const settingsFileName = "config.yaml"
+
+// Settings holds the user's preferences stored in config.yaml inside the
+// config directory.
+type Settings struct {
+	// Wizard starts the app in the step-by-step wizard instead of the grid.
+	Wizard bool `yaml:"wizard,omitempty"`
+	// Theme names a built-in theme or a custom one from the themes folder.
+	Theme string `yaml:"theme,omitempty"`
+	// Keys rebinds actions, keyed by context and then by action.
+	Keys map[string]map[string][]string `yaml:"keys,omitempty"`
+	// SkipConfirmation generates projects without asking for confirmation.
+	SkipConfirmation bool `yaml:"skipConfirmation,omitempty"`
+	// Hooks run inside every extracted project.
+	Hooks []hooks.Hook `yaml:"hooks,omitempty"`
+	// Templates is the template directory rendered into every extracted
+	// project, the templates folder of the config directory by default.
+	Templates string `yaml:"templates,omitempty"`
+	// TemplateConflicts tells what to do with the templates whose file was
+	// already generated: skip, overwrite or backup.
+	TemplateConflicts string `yaml:"templateConflicts,omitempty"`
+}
+
+// LoadSettings reads the user's settings, falling back to the defaults when
+// no settings file exists.
+func LoadSettings() (Settings, error) {
+	var s Settings
+	dir, err := Dir()
+	if err != nil {
+		return s, err
+	}
+	err = ReadYaml(filepath.Join(dir, settingsFileName), &s)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	return s, err
+}
+
+// settingKeys lists the keys of every setting in config.yaml.
+func settingKeys() map[string]bool {
+	keys := make(map[string]bool)
+	t := reflect.TypeOf(Settings{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+		keys[name] = true
+	}
+	return keys
+}
+
+// SaveSetting sets a single setting of config.yaml, inserting it when the
+// file doesn't have it yet. The comments, the order and every other key of the
+// file are left as written.
+func SaveSetting(key string, value any) error {
+	if !settingKeys()[key] {
+		return fmt.Errorf("unknown setting %q", key)
+	}
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+	fullPath := filepath.Join(dir, settingsFileName)
+
+	var doc yaml.Node
+	data, err := os.ReadFile(fullPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse %s: %v", fullPath, err)
+	}
+	if len(doc.Content) == 0 {
+		doc.Kind = yaml.DocumentNode
+		doc.Content = []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}
+	}
+	mapping := doc.Content[0]
+	if mapping.Kind != yaml.MappingNode {
+		return fmt.Errorf("failed to parse %s: the settings aren't a mapping", fullPath)
+	}
+
+	var node yaml.Node
+	if err := node.Encode(value); err != nil {
+		return err
+	}
+	found := false
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			node.LineComment = mapping.Content[i+1].LineComment
+			mapping.Content[i+1] = &node
+			found = true
+		}
+	}
+	if !found {
+		mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, &node)
+	}
+
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return err
+	}
+	return os.WriteFile(fullPath, out.Bytes(), 0o644)
+}
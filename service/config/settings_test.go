@@ -0,0 +1,151 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/eslam-allam/spring-initializer-go/service/hooks"
+)
+
+// settingsFile points the config directory at a temporary folder and returns
+// the path of its config.yaml.
+func settingsFile(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", home)
+	t.Setenv("HOME", home)
+	t.Setenv("AppData", home)
+	dir, err := Dir()
+	if err != nil {
+		t.Skip("no config directory")
+	}
+	return filepath.Join(dir, settingsFileName)
+}
+
+func TestLoadSettingsDefaults(t *testing.T) {
+	settingsFile(t)
+	s, err := LoadSettings()
+	if err != nil {
+		t.Fatalf("LoadSettings: %v", err)
+	}
+	if !reflect.DeepEqual(s, Settings{}) {
+		t.Errorf("got %+v, want the defaults", s)
+	}
+}
+
+func TestSaveSettingRoundTrip(t *testing.T) {
+	settingsFile(t)
+	want := Settings{
+		Wizard:            true,
+		Theme:             "solarized",
+		Keys:              map[string]map[string][]string{"main": {"quit": {"ctrl+x"}}},
+		SkipConfirmation:  true,
+		Hooks:             []hooks.Hook{{Run: "./mvnw verify"}, {Git: &hooks.Git{Branch: "main"}}},
+		Templates:         "~/templates",
+		TemplateConflicts: "backup",
+	}
+	settings := []struct {
+		key   string
+		value any
+	}{
+		{"wizard", want.Wizard},
+		{"theme", want.Theme},
+		{"keys", want.Keys},
+		{"skipConfirmation", want.SkipConfirmation},
+		{"hooks", want.Hooks},
+		{"templates", want.Templates},
+		{"templateConflicts", want.TemplateConflicts},
+	}
+	for _, s := range settings {
+		if err := SaveSetting(s.key, s.value); err != nil {
+			t.Fatalf("SaveSetting(%q): %v", s.key, err)
+		}
+	}
+	got, err := LoadSettings()
+	if err != nil {
+		t.Fatalf("LoadSettings: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestSaveSettingKeepsFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		want     string
+	}{
+		{
+			name: "update",
+			original: `# My settings
+theme: [light] # not a theme name
+# Start with the wizard
+wizard: false
+# Ask before generating
+skipConfirmation: false # for now
+plugins: [a, b] # unknown to the app
+`,
+			want: `# My settings
+theme: [light] # not a theme name
+# Start with the wizard
+wizard: false
+# Ask before generating
+skipConfirmation: true # for now
+plugins: [a, b] # unknown to the app
+`,
+		},
+		{
+			name: "insert",
+			original: `# My settings
+wizard: false
+keys:
+  main:
+    quit: [ctrl+x]
+`,
+			want: `# My settings
+wizard: false
+keys:
+  main:
+    quit: [ctrl+x]
+skipConfirmation: true
+`,
+		},
+		{
+			name: "new file",
+			want: "skipConfirmation: true\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fullPath := settingsFile(t)
+			if tt.original != "" {
+				if err := os.WriteFile(fullPath, []byte(tt.original), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := SaveSetting("skipConfirmation", true); err != nil {
+				t.Fatalf("SaveSetting: %v", err)
+			}
+			data, err := os.ReadFile(fullPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestSaveSettingUnknown(t *testing.T) {
+	fullPath := settingsFile(t)
+	if err := SaveSetting("plugins", []string{"a"}); err == nil {
+		t.Error("SaveSetting saved a setting the app doesn't know about")
+	}
+	if _, err := os.Stat(fullPath); err == nil {
+		t.Error("SaveSetting wrote the file for an unknown setting")
+	}
+}
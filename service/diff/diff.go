@@ -0,0 +1,153 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+type opKind int
+
+const (
+	equal opKind = iota
+	insert
+	remove
+)
+
+type op struct {
+	kind opKind
+	line string
+}
+
+// lineOps returns the edit script turning a into b, based on their longest
+// common subsequence.
+func lineOps(a, b []string) []op {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]op, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, op{equal, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, op{remove, a[i]})
+			i++
+		default:
+			ops = append(ops, op{insert, b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, op{remove, a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, op{insert, b[j]})
+	}
+	return ops
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// Unified returns the unified diff between a and b with the given number of
+// context lines, or an empty string if they are identical.
+func Unified(aName, bName, a, b string, context int) string {
+	ops := lineOps(splitLines(a), splitLines(b))
+
+	changed := false
+	for _, o := range ops {
+		if o.kind != equal {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	s := strings.Builder{}
+	s.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", aName, bName))
+
+	// aLine and bLine hold the 1-based line numbers reached before ops[i].
+	aLine, bLine := make([]int, len(ops)+1), make([]int, len(ops)+1)
+	aLine[0], bLine[0] = 1, 1
+	for i, o := range ops {
+		aLine[i+1], bLine[i+1] = aLine[i], bLine[i]
+		if o.kind != insert {
+			aLine[i+1]++
+		}
+		if o.kind != remove {
+			bLine[i+1]++
+		}
+	}
+
+	for start := 0; start < len(ops); {
+		for start < len(ops) && ops[start].kind == equal {
+			start++
+		}
+		if start == len(ops) {
+			break
+		}
+
+		// Grow the hunk until the gap between changes exceeds twice the
+		// context.
+		hunkStart := max(start-context, 0)
+		end := start
+		for {
+			for end < len(ops) && ops[end].kind != equal {
+				end++
+			}
+			next := end
+			for next < len(ops) && ops[next].kind == equal {
+				next++
+			}
+			if next == len(ops) || next-end > 2*context {
+				break
+			}
+			end = next
+		}
+		hunkEnd := min(end+context, len(ops))
+
+		aCount, bCount := aLine[hunkEnd]-aLine[hunkStart], bLine[hunkEnd]-bLine[hunkStart]
+		aStart, bStart := aLine[hunkStart], bLine[hunkStart]
+		if aCount == 0 {
+			aStart--
+		}
+		if bCount == 0 {
+			bStart--
+		}
+		s.WriteString(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", aStart, aCount, bStart, bCount))
+		for _, o := range ops[hunkStart:hunkEnd] {
+			switch o.kind {
+			case equal:
+				s.WriteString(" ")
+			case insert:
+				s.WriteString("+")
+			case remove:
+				s.WriteString("-")
+			}
+			s.WriteString(o.line)
+			s.WriteString("\n")
+		}
+		start = hunkEnd
+	}
+	return s.String()
+}
@@ -0,0 +1,70 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+)
+
+func lines(n ...string) string {
+	return strings.Join(n, "\n") + "\n"
+}
+
+func TestUnifiedIdentical(t *testing.T) {
+	if got := Unified("a", "b", lines("x", "y"), lines("x", "y"), 3); got != "" {
+		t.Errorf("got %q, want no diff", got)
+	}
+}
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		context int
+		want    string
+	}{
+		{
+			name:    "single change",
+			a:       lines("1", "2", "3", "4", "5"),
+			b:       lines("1", "2", "three", "4", "5"),
+			context: 1,
+			want: lines(
+				"--- a/pom.xml", "+++ b/pom.xml",
+				"@@ -2,3 +2,3 @@", " 2", "-3", "+three", " 4"),
+		},
+		{
+			name:    "separate hunks",
+			a:       lines("1", "2", "3", "4", "5", "6", "7", "8"),
+			b:       lines("one", "2", "3", "4", "5", "6", "7", "8", "9"),
+			context: 1,
+			want: lines(
+				"--- a/pom.xml", "+++ b/pom.xml",
+				"@@ -1,2 +1,2 @@", "-1", "+one", " 2",
+				"@@ -8,1 +8,2 @@", " 8", "+9"),
+		},
+		{
+			name:    "close changes share a hunk",
+			a:       lines("1", "2", "3", "4"),
+			b:       lines("one", "2", "3", "four"),
+			context: 1,
+			want: lines(
+				"--- a/pom.xml", "+++ b/pom.xml",
+				"@@ -1,4 +1,4 @@", "-1", "+one", " 2", " 3", "-4", "+four"),
+		},
+		{
+			name:    "new file",
+			a:       "",
+			b:       lines("a", "b"),
+			context: 3,
+			want: lines(
+				"--- a/pom.xml", "+++ b/pom.xml",
+				"@@ -0,0 +1,2 @@", "+a", "+b"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unified("a/pom.xml", "b/pom.xml", tt.a, tt.b, tt.context); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -50,20 +50,30 @@ func UnzipFile(zipFile, destDir string) error {
 	return nil
 }
 
-func ExpandAndMakeDir(targetDirectory string) (string, error) {
-	if strings.HasPrefix(targetDirectory, "~") {
+// ExpandPath expands a leading ~ to the home directory and makes the path
+// absolute.
+func ExpandPath(target string) (string, error) {
+	if strings.HasPrefix(target, "~") {
 		home, err := os.UserHomeDir()
 		if err != nil {
 			return "", fmt.Errorf("failed to get home directory: %v", err)
 		}
-		targetDirectory = strings.Replace(targetDirectory, "~", home, 1)
+		target = strings.Replace(target, "~", home, 1)
 	}
-	if !path.IsAbs(targetDirectory) {
+	if !path.IsAbs(target) {
 		cwd, err := os.Getwd()
 		if err != nil {
 			return "", fmt.Errorf("failed to get current working directory: %v", err)
 		}
-		targetDirectory = path.Join(cwd, targetDirectory)
+		target = path.Join(cwd, target)
+	}
+	return target, nil
+}
+
+func ExpandAndMakeDir(targetDirectory string) (string, error) {
+	targetDirectory, err := ExpandPath(targetDirectory)
+	if err != nil {
+		return "", err
 	}
 	if _, err := os.Stat(targetDirectory); errors.Is(err, os.ErrNotExist) {
 		logger.Printf("Target directory %s does not exist. Creating it now", targetDirectory)
@@ -74,3 +84,61 @@ func ExpandAndMakeDir(targetDirectory string) (string, error) {
 	}
 	return targetDirectory, nil
 }
+
+// ZipRoot returns the directory every file of the zip archive is in, or an
+// empty string when the files aren't in a single directory.
+func ZipRoot(zipFile string) (string, error) {
+	r, err := zip.OpenReader(zipFile)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	root := ""
+	for _, f := range r.File {
+		first, _, nested := strings.Cut(f.Name, "/")
+		if !nested || (root != "" && first != root) {
+			return "", nil
+		}
+		root = first
+	}
+	return root, nil
+}
+
+// CopyInto copies the file or directory src into the directory dir, keeping
+// its name.
+func CopyInto(src, dir string) error {
+	target := filepath.Join(dir, filepath.Base(src))
+	return filepath.WalkDir(src, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(target, rel)
+		if d.IsDir() {
+			return os.MkdirAll(dst, os.ModePerm)
+		}
+		return copyFile(p, dst)
+	})
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	_, err = io.Copy(out, in)
+	return err
+}
@@ -0,0 +1,95 @@
+package files
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeZip(t *testing.T, names ...string) string {
+	t.Helper()
+	zipFile := filepath.Join(t.TempDir(), "project.zip")
+	f, err := os.Create(zipFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	for _, name := range names {
+		if _, err := w.Create(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return zipFile
+}
+
+func TestZipRoot(t *testing.T) {
+	tests := []struct {
+		name  string
+		names []string
+		want  string
+	}{
+		{"single root", []string{"demo/", "demo/pom.xml", "demo/src/Main.java"}, "demo"},
+		{"several roots", []string{"demo/pom.xml", "other/pom.xml"}, ""},
+		{"top level file", []string{"demo/pom.xml", "HELP.md"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ZipRoot(writeZip(t, tt.names...))
+			if err != nil {
+				t.Fatalf("ZipRoot: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ZipRoot = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZipRootInvalidArchive(t *testing.T) {
+	notZip := filepath.Join(t.TempDir(), "project.zip")
+	if err := os.WriteFile(notZip, []byte("not a zip"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ZipRoot(notZip); err == nil {
+		t.Error("expected an error for a file that isn't a zip archive")
+	}
+}
+
+func TestCopyInto(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "config")
+	if err := os.MkdirAll(filepath.Join(src, "nested"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "nested", "app.yaml"), []byte("port: 8080"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	single := filepath.Join(t.TempDir(), ".editorconfig")
+	if err := os.WriteFile(single, []byte("root = true"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	for _, s := range []string{src, single} {
+		if err := CopyInto(s, dir); err != nil {
+			t.Fatalf("CopyInto(%s): %v", s, err)
+		}
+	}
+	for path, want := range map[string]string{
+		"config/nested/app.yaml": "port: 8080",
+		".editorconfig":          "root = true",
+	} {
+		got, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(path)))
+		if err != nil {
+			t.Errorf("reading %s: %v", path, err)
+			continue
+		}
+		if string(got) != want {
+			t.Errorf("%s = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package hooks
+
+import (
+	"fmt"
+	"net/mail"
+	"os"
+	"os/exec"
+	"slices"
+)
+
+const defaultCommitMessage = "Initial commit"
+
+// Git creates a repository with a first commit of the generated files, left
+// out the ones ignored by the generated .gitignore. It only needs a local git
+// binary.
+type Git struct {
+	// Message of the first commit, "Initial commit" by default.
+	Message string `yaml:"message,omitempty"`
+	// Author of the first commit such as "Jane Doe <jane@example.com>",
+	// falling back to the user's git configuration.
+	Author string `yaml:"author,omitempty"`
+	// Branch names the first branch instead of git's default.
+	Branch string `yaml:"branch,omitempty"`
+}
+
+// WithGit returns hs followed by a git step with the default settings, unless
+// one of them already creates a repository.
+func WithGit(hs []Hook) []Hook {
+	if HasGit(hs) {
+		return hs
+	}
+	return append(slices.Clip(hs), Hook{Git: &Git{}})
+}
+
+// HasGit reports whether one of hs creates a repository.
+func HasGit(hs []Hook) bool {
+	return slices.ContainsFunc(hs, func(h Hook) bool {
+		return h.Git != nil
+	})
+}
+
+// git returns a git command whose commits are authored by author, if set.
+func git(author *mail.Address, args ...string) *exec.Cmd {
+	cmd := exec.Command("git", args...)
+	if author != nil {
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME="+author.Name, "GIT_AUTHOR_EMAIL="+author.Address,
+			"GIT_COMMITTER_NAME="+author.Name, "GIT_COMMITTER_EMAIL="+author.Address)
+	}
+	return cmd
+}
+
+func initGit(g Git, dir string, output func(line string)) error {
+	if _, err := exec.LookPath("git"); err != nil {
+		return fmt.Errorf("git is not installed: %v", err)
+	}
+	var author *mail.Address
+	if g.Author != "" {
+		var err error
+		if author, err = mail.ParseAddress(g.Author); err != nil {
+			return fmt.Errorf("invalid author %q: %v", g.Author, err)
+		}
+	}
+	message := g.Message
+	if message == "" {
+		message = defaultCommitMessage
+	}
+
+	initArgs := []string{"init"}
+	if g.Branch != "" {
+		initArgs = append(initArgs, "--initial-branch", g.Branch)
+	}
+	for _, cmd := range []*exec.Cmd{
+		git(author, initArgs...),
+		git(author, "add", "--all"),
+		git(author, "commit", "--quiet", "--message", message),
+	} {
+		if err := runCommand(cmd, dir, output); err != nil {
+			return fmt.Errorf("%s failed: %v", cmd.Args[1], err)
+		}
+	}
+	output(fmt.Sprintf("Committed the generated files: %s", message))
+	return nil
+}
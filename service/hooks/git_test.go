@@ -0,0 +1,92 @@
+package hooks
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// gitOutput runs git inside dir and returns its trimmed output.
+func gitOutput(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestInitGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	// Keep the user's git configuration out of the test.
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		".gitignore":     "target/\n",
+		"pom.xml":        "<project/>",
+		"target/app.jar": "binary",
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	g := Git{Author: "Jane Doe <jane@example.com>", Branch: "trunk"}
+	if err := initGit(g, dir, func(string) {}); err != nil {
+		t.Fatalf("initGit: %v", err)
+	}
+
+	if got := gitOutput(t, dir, "log", "--format=%an <%ae>|%s"); got != "Jane Doe <jane@example.com>|Initial commit" {
+		t.Errorf("commit = %q", got)
+	}
+	if got := gitOutput(t, dir, "branch", "--show-current"); got != "trunk" {
+		t.Errorf("branch = %q, want trunk", got)
+	}
+	if got := gitOutput(t, dir, "ls-files"); got != ".gitignore\npom.xml" {
+		t.Errorf("committed files = %q, want the ones not ignored", got)
+	}
+}
+
+func TestInitGitInvalidAuthor(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	err := initGit(Git{Author: "not an address"}, t.TempDir(), func(string) {})
+	if err == nil || !strings.Contains(err.Error(), "invalid author") {
+		t.Errorf("initGit error = %v, want an invalid author error", err)
+	}
+}
+
+func TestWithGit(t *testing.T) {
+	configured := []Hook{{Run: "./mvnw -q verify"}}
+	hs := WithGit(configured)
+	if len(hs) != 2 || hs[0].Run != "./mvnw -q verify" || hs[1].Git == nil || *hs[1].Git != (Git{}) {
+		t.Errorf("WithGit() = %+v, want the configured hook followed by a default git step", hs)
+	}
+	if len(configured) != 1 {
+		t.Errorf("WithGit() changed the configured hooks: %+v", configured)
+	}
+	if !HasGit(hs) || HasGit(configured) {
+		t.Error("HasGit() doesn't tell the hooks creating a repository")
+	}
+
+	withMessage := []Hook{{Git: &Git{Message: "chore: generate"}}, {Run: "make"}}
+	if hs := WithGit(withMessage); len(hs) != 2 || hs[0].Git.Message != "chore: generate" {
+		t.Errorf("WithGit() = %+v, want the configured git step alone", hs)
+	}
+	if hs := WithGit(nil); len(hs) != 1 || hs[0].Git == nil {
+		t.Errorf("WithGit(nil) = %+v, want a git step", hs)
+	}
+}
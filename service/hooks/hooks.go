@@ -0,0 +1,134 @@
+// Package hooks runs the post-generation steps configured in the hooks
+// section of config.yaml inside a freshly extracted project:
+//
+//	hooks:
+//	  - name: Company editorconfig
+//	    copy: ~/templates/.editorconfig
+//	  - run: ./mvnw -q verify
+//	  - git:
+//	      message: Initial commit
+package hooks
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/eslam-allam/spring-initializer-go/service/files"
+)
+
+// Hook is a single step, either a shell command or one of the built-in
+// steps.
+type Hook struct {
+	// Name is shown in the log instead of the step itself.
+	Name string `yaml:"name,omitempty"`
+	// Run is a command run with the user's shell.
+	Run string `yaml:"run,omitempty"`
+	// Copy is a file or directory copied into the project.
+	Copy string `yaml:"copy,omitempty"`
+	// Git turns the project into a git repository.
+	Git *Git `yaml:"git,omitempty"`
+}
+
+func (h Hook) Title() string {
+	switch {
+	case h.Name != "":
+		return h.Name
+	case h.Run != "":
+		return h.Run
+	case h.Copy != "":
+		return "copy " + h.Copy
+	case h.Git != nil:
+		return "git init"
+	default:
+		return "empty hook"
+	}
+}
+
+// Event reports a line of output of the step at index Step, or that the step
+// is Done, having failed when Err is set.
+type Event struct {
+	Step int
+	Line string
+	Done bool
+	Err  error
+}
+
+// Run runs the hooks one after another inside dir. Their output is sent on
+// the returned channel as it comes, which is closed once every step is done.
+// A failing step doesn't stop the following ones.
+func Run(hooks []Hook, dir string) <-chan Event {
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		for i, h := range hooks {
+			err := run(h, dir, func(line string) {
+				events <- Event{Step: i, Line: line}
+			})
+			events <- Event{Step: i, Done: true, Err: err}
+		}
+	}()
+	return events
+}
+
+func run(h Hook, dir string, output func(line string)) error {
+	switch {
+	case h.Run != "":
+		return runCommand(shell(h.Run), dir, output)
+	case h.Copy != "":
+		src, err := files.ExpandPath(h.Copy)
+		if err != nil {
+			return err
+		}
+		if err := files.CopyInto(src, dir); err != nil {
+			return fmt.Errorf("failed to copy %s: %v", src, err)
+		}
+		output(fmt.Sprintf("Copied %s", src))
+		return nil
+	case h.Git != nil:
+		return initGit(*h.Git, dir, output)
+	default:
+		return errors.New("hook has neither run, copy nor git")
+	}
+}
+
+// shell returns the command running line with the user's shell.
+func shell(line string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.Command("cmd", "/C", line)
+	}
+	sh := os.Getenv("SHELL")
+	if sh == "" {
+		sh = "sh"
+	}
+	return exec.Command(sh, "-c", line)
+}
+
+func runCommand(cmd *exec.Cmd, dir string, output func(line string)) error {
+	cmd.Dir = dir
+	r, w := io.Pipe()
+	cmd.Stdout = w
+	cmd.Stderr = w
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			output(scanner.Text())
+		}
+		// Keep draining so that the command never blocks on a long line.
+		io.Copy(io.Discard, r)
+	}()
+	err := cmd.Wait()
+	w.Close()
+	<-done
+	return err
+}
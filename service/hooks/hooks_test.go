@@ -0,0 +1,89 @@
+package hooks
+
+import (
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func TestRunSequencesEvents(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+	t.Setenv("SHELL", "sh")
+
+	hs := []Hook{
+		{Run: "echo one; echo two"},
+		{Run: "exit 3"},
+		{},
+		{Name: "last", Run: "echo three"},
+	}
+	type event struct {
+		step   int
+		line   string
+		done   bool
+		failed bool
+	}
+	got := make([]event, 0)
+	for e := range Run(hs, t.TempDir()) {
+		got = append(got, event{e.Step, e.Line, e.Done, e.Err != nil})
+	}
+	want := []event{
+		{0, "one", false, false},
+		{0, "two", false, false},
+		{0, "", true, false},
+		{1, "", true, true},
+		{2, "", true, true},
+		{3, "three", false, false},
+		{3, "", true, false},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestRunInsideDir(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+	t.Setenv("SHELL", "sh")
+	dir := t.TempDir()
+	var lines []string
+	for e := range Run([]Hook{{Run: "pwd -P"}}, dir) {
+		if e.Err != nil {
+			t.Fatalf("hook failed: %v", e.Err)
+		}
+		if !e.Done {
+			lines = append(lines, e.Line)
+		}
+	}
+	if len(lines) != 1 {
+		t.Fatalf("got output %v, want the working directory", lines)
+	}
+	want, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines[0] != want {
+		t.Errorf("ran in %q, want %q", lines[0], want)
+	}
+}
+
+func TestTitle(t *testing.T) {
+	tests := []struct {
+		hook Hook
+		want string
+	}{
+		{Hook{Name: "Verify", Run: "./mvnw verify"}, "Verify"},
+		{Hook{Run: "./mvnw verify"}, "./mvnw verify"},
+		{Hook{Copy: "~/.editorconfig"}, "copy ~/.editorconfig"},
+		{Hook{Git: &Git{}}, "git init"},
+		{Hook{}, "empty hook"},
+	}
+	for _, tt := range tests {
+		if got := tt.hook.Title(); got != tt.want {
+			t.Errorf("Title() = %q, want %q", got, tt.want)
+		}
+	}
+}
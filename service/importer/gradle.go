@@ -0,0 +1,92 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/eslam-allam/spring-initializer-go/service/springio"
+)
+
+// The expressions below cover both the Groovy and the Kotlin DSL as
+// generated by Spring Initializr.
+var (
+	gradleBootPlugin  = regexp.MustCompile(`id\s*\(?\s*["']org\.springframework\.boot["']\s*\)?\s*version\s*["']([^"']+)["']`)
+	gradleGroup       = regexp.MustCompile(`(?m)^\s*group\s*=\s*["']([^"']+)["']`)
+	gradleDescription = regexp.MustCompile(`(?m)^\s*description\s*=\s*["']([^"']+)["']`)
+	gradleToolchain   = regexp.MustCompile(`JavaLanguageVersion\.of\(\s*(\d+)\s*\)`)
+	gradleCompat      = regexp.MustCompile(`sourceCompatibility\s*=\s*(?:JavaVersion\.VERSION_|["'])([\d_.]+)`)
+	gradleKotlin      = regexp.MustCompile(`kotlin\(\s*"jvm"\s*\)|["']org\.jetbrains\.kotlin\.jvm["']`)
+	gradleGroovy      = regexp.MustCompile(`(?m)^\s*id\s*\(?\s*["']groovy["']`)
+	gradleWar         = regexp.MustCompile(`(?m)^\s*(?:id\s*\(?\s*["']war["']|war\b)`)
+	gradleDependency  = regexp.MustCompile(`(?m)^\s*(\w+)\s*\(?\s*["']([\w.\-]+):([\w.\-]+)(?::[^"']*)?["']`)
+	gradleRootName    = regexp.MustCompile(`rootProject\.name\s*=\s*["']([^"']+)["']`)
+)
+
+func readGradle(path string) (springio.ProjectConfig, []artifact, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return springio.ProjectConfig{}, nil, err
+	}
+	build := string(data)
+
+	c := springio.ProjectConfig{
+		Type:      "gradle-project",
+		Language:  "java",
+		Packaging: "jar",
+		Metadata:  make(map[string]string),
+	}
+	if strings.HasSuffix(path, ".kts") {
+		c.Type = "gradle-project-kotlin"
+	}
+
+	find := func(re *regexp.Regexp, s string) string {
+		if match := re.FindStringSubmatch(s); match != nil {
+			return match[1]
+		}
+		return ""
+	}
+
+	c.BootVersion = find(gradleBootPlugin, build)
+	c.JavaVersion = find(gradleToolchain, build)
+	if c.JavaVersion == "" {
+		c.JavaVersion = strings.ReplaceAll(find(gradleCompat, build), "_", ".")
+	}
+	switch {
+	case gradleKotlin.MatchString(build):
+		c.Language = "kotlin"
+	case gradleGroovy.MatchString(build):
+		c.Language = "groovy"
+	}
+	if gradleWar.MatchString(build) {
+		c.Packaging = "war"
+	}
+
+	if group := find(gradleGroup, build); group != "" {
+		c.Metadata["groupId"] = group
+	}
+	if description := find(gradleDescription, build); description != "" {
+		c.Metadata["description"] = description
+	}
+	for _, settings := range []string{"settings.gradle.kts", "settings.gradle"} {
+		data, err := os.ReadFile(filepath.Join(filepath.Dir(path), settings))
+		if err != nil {
+			continue
+		}
+		if name := find(gradleRootName, string(data)); name != "" {
+			c.Metadata["artifactId"] = name
+			c.Metadata["name"] = name
+		}
+		break
+	}
+
+	artifacts := make([]artifact, 0)
+	for _, match := range gradleDependency.FindAllStringSubmatch(build, -1) {
+		if match[1] == "mavenBom" || match[1] == "classpath" {
+			continue
+		}
+		artifacts = append(artifacts, artifact{groupId: match[2], artifactId: match[3]})
+	}
+	return c, artifacts, nil
+}
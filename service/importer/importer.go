@@ -0,0 +1,214 @@
+package importer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/eslam-allam/spring-initializer-go/service/springio"
+)
+
+// buildFiles are the files looked up, in order, when importing a directory.
+var buildFiles = []string{"pom.xml", "build.gradle.kts", "build.gradle"}
+
+// implicitArtifacts are added by Spring Initializr to every project, so they
+// never need to be mapped back to a dependency.
+var implicitArtifacts = map[string]struct{}{
+	"spring-boot-starter":        {},
+	"spring-boot-starter-test":   {},
+	"junit-platform-launcher":    {},
+	"reactor-test":               {},
+	"spring-security-test":       {},
+	"spring-boot-testcontainers": {},
+	"kotlin-reflect":             {},
+	"kotlin-stdlib":              {},
+	"kotlin-stdlib-jdk8":         {},
+	"jackson-module-kotlin":      {},
+	"reactor-kotlin-extensions":  {},
+	"kotlinx-coroutines-reactor": {},
+	"groovy":                     {},
+}
+
+// aliases maps artifacts whose Initializr dependency id can't be derived from
+// the artifact name.
+var aliases = map[string]string{
+	"mysql-connector-j":                 "mysql",
+	"mysql-connector-java":              "mysql",
+	"mssql-jdbc":                        "sqlserver",
+	"ojdbc11":                           "oracle",
+	"ojdbc8":                            "oracle",
+	"flyway-core":                       "flyway",
+	"liquibase-core":                    "liquibase",
+	"spring-boot-starter-data-jdbc":     "data-jdbc",
+	"spring-boot-starter-oauth2-client": "oauth2-client",
+	"spring-boot-starter-oauth2-resource-server": "oauth2-resource-server",
+	"spring-cloud-starter-config":                "cloud-config-client",
+	"spring-cloud-config-server":                 "cloud-config-server",
+	"spring-cloud-starter-netflix-eureka-client": "cloud-eureka",
+	"spring-cloud-starter-netflix-eureka-server": "cloud-eureka-server",
+	"spring-cloud-starter-openfeign":             "cloud-feign",
+	"spring-kafka":                               "kafka",
+	"spring-rabbit":                              "amqp",
+	"micrometer-registry-prometheus":             "prometheus",
+}
+
+type artifact struct {
+	groupId    string
+	artifactId string
+}
+
+func (a artifact) String() string {
+	if a.groupId == "" {
+		return a.artifactId
+	}
+	return fmt.Sprintf("%s:%s", a.groupId, a.artifactId)
+}
+
+// Result holds the configuration read from a build file along with the
+// artifacts that don't match any Initializr dependency.
+type Result struct {
+	BuildFile string
+	Config    springio.ProjectConfig
+	Unmapped  []string
+}
+
+// Import reads the Maven or Gradle build file at path, or the first one found
+// if path is a directory, and maps its artifacts onto the given dependency
+// ids.
+func Import(path string, dependencyIds []string) (Result, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return Result{}, err
+	}
+	buildFile := path
+	if info.IsDir() {
+		buildFile = ""
+		for _, name := range buildFiles {
+			candidate := filepath.Join(path, name)
+			if _, err := os.Stat(candidate); err == nil {
+				buildFile = candidate
+				break
+			}
+		}
+		if buildFile == "" {
+			return Result{}, fmt.Errorf("no pom.xml or build.gradle found in %s", path)
+		}
+	}
+
+	var c springio.ProjectConfig
+	var artifacts []artifact
+	switch name := filepath.Base(buildFile); {
+	case name == "pom.xml":
+		c, artifacts, err = readPom(buildFile)
+	case strings.HasPrefix(name, "build.gradle"):
+		c, artifacts, err = readGradle(buildFile)
+	default:
+		return Result{}, fmt.Errorf("unsupported build file: %s", name)
+	}
+	if err != nil {
+		return Result{}, err
+	}
+
+	if _, ok := c.Metadata["packageName"]; !ok {
+		if packageName, err := findPackageName(filepath.Dir(buildFile)); err == nil {
+			c.Metadata["packageName"] = packageName
+		}
+	}
+
+	known := make(map[string]struct{}, len(dependencyIds))
+	for _, id := range dependencyIds {
+		known[id] = struct{}{}
+	}
+
+	result := Result{BuildFile: buildFile, Config: c, Unmapped: make([]string, 0)}
+	result.Config.Dependencies = make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, a := range artifacts {
+		if _, ok := implicitArtifacts[a.artifactId]; ok {
+			continue
+		}
+		id, ok := mapArtifact(a.artifactId, known)
+		if !ok {
+			result.Unmapped = append(result.Unmapped, a.String())
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result.Config.Dependencies = append(result.Config.Dependencies, id)
+	}
+	return result, nil
+}
+
+// mapArtifact guesses the Initializr dependency id of an artifact by trying
+// the known aliases followed by the common starter naming schemes.
+func mapArtifact(artifactId string, known map[string]struct{}) (string, bool) {
+	candidates := make([]string, 0)
+	if alias, ok := aliases[artifactId]; ok {
+		candidates = append(candidates, alias)
+	}
+	switch {
+	case strings.HasPrefix(artifactId, "spring-boot-starter-"):
+		candidates = append(candidates, strings.TrimPrefix(artifactId, "spring-boot-starter-"))
+	case strings.HasPrefix(artifactId, "spring-cloud-starter-"):
+		candidates = append(candidates, "cloud-"+strings.TrimPrefix(artifactId, "spring-cloud-starter-"))
+	case strings.HasPrefix(artifactId, "spring-boot-"):
+		candidates = append(candidates, strings.TrimPrefix(artifactId, "spring-boot-"))
+	}
+	candidates = append(candidates, artifactId, strings.TrimSuffix(artifactId, "-core"))
+
+	for _, candidate := range candidates {
+		if _, ok := known[candidate]; ok {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// findPackageName returns the package of the class annotated with
+// @SpringBootApplication in the project's main sources.
+func findPackageName(projectDir string) (string, error) {
+	var packageName string
+	root := filepath.Join(projectDir, "src", "main")
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || packageName != "" {
+			return err
+		}
+		switch filepath.Ext(path) {
+		case ".java", ".kt", ".groovy":
+		default:
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		var declared string
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if strings.HasPrefix(line, "package ") {
+				declared = strings.TrimSuffix(strings.TrimSpace(strings.TrimPrefix(line, "package ")), ";")
+			}
+			if strings.HasPrefix(line, "@SpringBootApplication") {
+				packageName = declared
+				return fs.SkipAll
+			}
+		}
+		return scanner.Err()
+	})
+	if err != nil {
+		return "", err
+	}
+	if packageName == "" {
+		return "", errors.New("no @SpringBootApplication class found")
+	}
+	return packageName, nil
+}
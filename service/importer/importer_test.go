@@ -0,0 +1,185 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/eslam-allam/spring-initializer-go/service/springio"
+)
+
+var dependencyIds = []string{"web", "data-jpa", "postgresql", "flyway", "kafka", "cloud-feign", "lombok"}
+
+const pomXml = `<?xml version="1.0" encoding="UTF-8"?>
+<project>
+	<parent>
+		<groupId>org.springframework.boot</groupId>
+		<artifactId>spring-boot-starter-parent</artifactId>
+		<version>3.2.0</version>
+	</parent>
+	<groupId>com.acme</groupId>
+	<artifactId>orders</artifactId>
+	<name>orders</name>
+	<description>Order service</description>
+	<properties>
+		<java.version>21</java.version>
+	</properties>
+	<dependencies>
+		<dependency><groupId>org.springframework.boot</groupId><artifactId>spring-boot-starter-web</artifactId></dependency>
+		<dependency><groupId>org.springframework.boot</groupId><artifactId>spring-boot-starter-data-jpa</artifactId></dependency>
+		<dependency><groupId>org.flywaydb</groupId><artifactId>flyway-core</artifactId></dependency>
+		<dependency><groupId>org.projectlombok</groupId><artifactId>lombok</artifactId></dependency>
+		<dependency><groupId>org.springframework.boot</groupId><artifactId>spring-boot-starter-test</artifactId></dependency>
+		<dependency><groupId>com.acme</groupId><artifactId>acme-commons</artifactId></dependency>
+	</dependencies>
+	<build>
+		<plugins>
+			<plugin><artifactId>kotlin-maven-plugin</artifactId></plugin>
+		</plugins>
+	</build>
+</project>`
+
+const buildGradleKts = `plugins {
+	java
+	id("org.springframework.boot") version "3.1.6"
+	id("io.spring.dependency-management") version "1.1.4"
+	war
+}
+
+group = "com.acme"
+description = "Billing service"
+
+java {
+	toolchain {
+		languageVersion = JavaLanguageVersion.of(17)
+	}
+}
+
+dependencies {
+	implementation("org.springframework.boot:spring-boot-starter-web")
+	implementation("org.springframework.kafka:spring-kafka")
+	implementation("org.springframework.cloud:spring-cloud-starter-openfeign")
+	runtimeOnly("org.postgresql:postgresql")
+	testImplementation("org.springframework.boot:spring-boot-starter-test")
+}
+`
+
+const application = `package com.acme.orders;
+
+import org.springframework.boot.autoconfigure.SpringBootApplication;
+
+@SpringBootApplication
+public class OrdersApplication {
+}
+`
+
+// writeProject creates the given files, keyed by their slash separated path,
+// in a new project directory.
+func writeProject(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestImportPom(t *testing.T) {
+	dir := writeProject(t, map[string]string{
+		"pom.xml": pomXml,
+		"src/main/java/com/acme/orders/OrdersApplication.java": application,
+	})
+	result, err := Import(dir, dependencyIds)
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	want := springio.ProjectConfig{
+		Type:         "maven-project",
+		Language:     "kotlin",
+		BootVersion:  "3.2.0",
+		Packaging:    "jar",
+		JavaVersion:  "21",
+		Dependencies: []string{"web", "data-jpa", "flyway", "lombok"},
+		Metadata: map[string]string{
+			"groupId":     "com.acme",
+			"artifactId":  "orders",
+			"name":        "orders",
+			"description": "Order service",
+			"packageName": "com.acme.orders",
+		},
+	}
+	if !reflect.DeepEqual(result.Config, want) {
+		t.Errorf("config %+v, want %+v", result.Config, want)
+	}
+	if !reflect.DeepEqual(result.Unmapped, []string{"com.acme:acme-commons"}) {
+		t.Errorf("unmapped %v", result.Unmapped)
+	}
+	if result.BuildFile != filepath.Join(dir, "pom.xml") {
+		t.Errorf("build file %s", result.BuildFile)
+	}
+}
+
+func TestImportGradleKotlin(t *testing.T) {
+	dir := writeProject(t, map[string]string{
+		"build.gradle.kts":    buildGradleKts,
+		"settings.gradle.kts": `rootProject.name = "billing"`,
+	})
+	result, err := Import(filepath.Join(dir, "build.gradle.kts"), dependencyIds)
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	want := springio.ProjectConfig{
+		Type:         "gradle-project-kotlin",
+		Language:     "java",
+		BootVersion:  "3.1.6",
+		Packaging:    "war",
+		JavaVersion:  "17",
+		Dependencies: []string{"web", "kafka", "cloud-feign", "postgresql"},
+		Metadata: map[string]string{
+			"groupId":     "com.acme",
+			"description": "Billing service",
+			"artifactId":  "billing",
+			"name":        "billing",
+		},
+	}
+	if !reflect.DeepEqual(result.Config, want) {
+		t.Errorf("config %+v, want %+v", result.Config, want)
+	}
+	if len(result.Unmapped) != 0 {
+		t.Errorf("unmapped %v", result.Unmapped)
+	}
+}
+
+func TestImportWithoutBuildFile(t *testing.T) {
+	if _, err := Import(t.TempDir(), dependencyIds); err == nil {
+		t.Error("expected an error for a directory without a build file")
+	}
+}
+
+func TestMapArtifact(t *testing.T) {
+	known := map[string]struct{}{"mysql": {}, "cloud-config-client": {}, "actuator": {}, "liquibase": {}}
+	tests := []struct {
+		artifactId string
+		want       string
+		ok         bool
+	}{
+		{"mysql-connector-j", "mysql", true},
+		{"spring-cloud-starter-config", "cloud-config-client", true},
+		{"spring-boot-starter-actuator", "actuator", true},
+		{"liquibase-core", "liquibase", true},
+		{"guava", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := mapArtifact(tt.artifactId, known)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("mapArtifact(%q) = %q, %v, want %q, %v", tt.artifactId, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package importer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+
+	"github.com/eslam-allam/spring-initializer-go/service/springio"
+)
+
+type pom struct {
+	Parent struct {
+		ArtifactId string `xml:"artifactId"`
+		Version    string `xml:"version"`
+	} `xml:"parent"`
+	GroupId     string `xml:"groupId"`
+	ArtifactId  string `xml:"artifactId"`
+	Name        string `xml:"name"`
+	Description string `xml:"description"`
+	Packaging   string `xml:"packaging"`
+	Properties  struct {
+		JavaVersion string `xml:"java.version"`
+	} `xml:"properties"`
+	Dependencies []struct {
+		GroupId    string `xml:"groupId"`
+		ArtifactId string `xml:"artifactId"`
+	} `xml:"dependencies>dependency"`
+	Plugins []struct {
+		ArtifactId string `xml:"artifactId"`
+	} `xml:"build>plugins>plugin"`
+}
+
+func readPom(path string) (springio.ProjectConfig, []artifact, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return springio.ProjectConfig{}, nil, err
+	}
+	var p pom
+	if err := xml.Unmarshal(data, &p); err != nil {
+		return springio.ProjectConfig{}, nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+
+	c := springio.ProjectConfig{
+		Type:        "maven-project",
+		Language:    "java",
+		Packaging:   p.Packaging,
+		JavaVersion: p.Properties.JavaVersion,
+		Metadata:    make(map[string]string),
+	}
+	if p.Parent.ArtifactId == "spring-boot-starter-parent" {
+		c.BootVersion = p.Parent.Version
+	}
+	if c.Packaging == "" {
+		c.Packaging = "jar"
+	}
+	for id, value := range map[string]string{
+		"groupId":     p.GroupId,
+		"artifactId":  p.ArtifactId,
+		"name":        p.Name,
+		"description": p.Description,
+	} {
+		if value != "" {
+			c.Metadata[id] = value
+		}
+	}
+
+	for _, plugin := range p.Plugins {
+		switch plugin.ArtifactId {
+		case "kotlin-maven-plugin":
+			c.Language = "kotlin"
+		case "gmavenplus-plugin":
+			c.Language = "groovy"
+		}
+	}
+
+	artifacts := make([]artifact, len(p.Dependencies))
+	for i, d := range p.Dependencies {
+		artifacts[i] = artifact{groupId: d.GroupId, artifactId: d.ArtifactId}
+	}
+	return c, artifacts, nil
+}
@@ -0,0 +1,100 @@
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/eslam-allam/spring-initializer-go/service/config"
+	"github.com/eslam-allam/spring-initializer-go/service/springio"
+)
+
+const (
+	profilesDirName = "profiles"
+	profileFileExt  = ".yaml"
+)
+
+// Profile is a named project configuration stored in the profiles folder of
+// the config directory.
+type Profile struct {
+	Name                   string `yaml:"name"`
+	springio.ProjectConfig `yaml:",inline"`
+	// Templates is a template directory rendered into the projects generated
+	// with the profile, after the global one.
+	Templates string `yaml:"templates,omitempty"`
+}
+
+func fileName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		}
+		return '-'
+	}, name)
+	return name + profileFileExt
+}
+
+func read(fullPath string) (Profile, error) {
+	var p Profile
+	if err := config.ReadYaml(fullPath, &p); err != nil {
+		return p, err
+	}
+	if p.Name == "" {
+		p.Name = strings.TrimSuffix(filepath.Base(fullPath), profileFileExt)
+	}
+	return p, nil
+}
+
+// List returns every stored profile sorted by name.
+func List() ([]Profile, error) {
+	dir, err := config.SubDir(profilesDirName)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := filepath.Glob(filepath.Join(dir, "*"+profileFileExt))
+	if err != nil {
+		return nil, err
+	}
+	profiles := make([]Profile, 0, len(matches))
+	for _, match := range matches {
+		p, err := read(match)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, p)
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+	return profiles, nil
+}
+
+// Load reads the profile with the given name.
+func Load(name string) (Profile, error) {
+	dir, err := config.SubDir(profilesDirName)
+	if err != nil {
+		return Profile{}, err
+	}
+	p, err := read(filepath.Join(dir, fileName(name)))
+	if errors.Is(err, os.ErrNotExist) {
+		return p, fmt.Errorf("profile %q does not exist", name)
+	}
+	return p, err
+}
+
+// Save writes the profile, replacing any profile with the same name.
+func Save(p Profile) error {
+	if strings.TrimSpace(p.Name) == "" {
+		return errors.New("profile name cannot be empty")
+	}
+	dir, err := config.SubDir(profilesDirName)
+	if err != nil {
+		return err
+	}
+	return config.WriteYaml(filepath.Join(dir, fileName(p.Name)), p)
+}
@@ -0,0 +1,90 @@
+package profile
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/eslam-allam/spring-initializer-go/service/springio"
+)
+
+// useConfigDir points the config directory at a temporary folder.
+func useConfigDir(t *testing.T) {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+	t.Setenv("AppData", dir)
+	if _, err := os.UserConfigDir(); err != nil {
+		t.Skip("no config directory")
+	}
+}
+
+func TestFileName(t *testing.T) {
+	tests := map[string]string{
+		"rest-api":         "rest-api.yaml",
+		" Rest API ":       "rest-api.yaml",
+		"orders/service_2": "orders-service_2.yaml",
+	}
+	for name, want := range tests {
+		if got := fileName(name); got != want {
+			t.Errorf("fileName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestSaveLoadList(t *testing.T) {
+	useConfigDir(t)
+	saved := []Profile{
+		{
+			Name: "rest-api",
+			ProjectConfig: springio.ProjectConfig{
+				Type:         "maven-project",
+				JavaVersion:  "21",
+				Dependencies: []string{"web", "data-jpa"},
+				Metadata:     map[string]string{"groupId": "com.example"},
+			},
+			Templates: "~/templates/rest",
+		},
+		{Name: "Batch Job", ProjectConfig: springio.ProjectConfig{Dependencies: []string{"batch"}}},
+	}
+	for _, p := range saved {
+		if err := Save(p); err != nil {
+			t.Fatalf("Save(%s): %v", p.Name, err)
+		}
+	}
+
+	got, err := Load("rest-api")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !reflect.DeepEqual(got, saved[0]) {
+		t.Errorf("Load = %+v, want %+v", got, saved[0])
+	}
+
+	profiles, err := List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	names := make([]string, len(profiles))
+	for i, p := range profiles {
+		names[i] = p.Name
+	}
+	if !reflect.DeepEqual(names, []string{"Batch Job", "rest-api"}) {
+		t.Errorf("List names = %v, want them sorted", names)
+	}
+}
+
+func TestLoadMissing(t *testing.T) {
+	useConfigDir(t)
+	if _, err := Load("nope"); err == nil {
+		t.Error("expected an error for a missing profile")
+	}
+}
+
+func TestSaveWithoutName(t *testing.T) {
+	useConfigDir(t)
+	if err := Save(Profile{Name: "  "}); err == nil {
+		t.Error("expected an error for an empty profile name")
+	}
+}
@@ -0,0 +1,85 @@
+package springio
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// InitializrError is returned when Spring Initializr refuses to generate a
+// project. It holds the error response of the server, such as
+//
+//	{"status":400,"error":"Bad Request","message":"Unknown dependency 'foo'","path":"/starter.zip"}
+type InitializrError struct {
+	Status  int    `json:"status"`
+	Reason  string `json:"error"`
+	Message string `json:"message"`
+	Path    string `json:"path"`
+	// Body is the raw response, kept as is when it isn't a JSON error.
+	Body string `json:"-"`
+}
+
+// parseInitializrError reads the error response of a failed request. Missing
+// fields are filled from the response status.
+func parseInitializrError(resp *http.Response, body []byte) *InitializrError {
+	e := &InitializrError{}
+	_ = json.Unmarshal(body, e)
+	e.Body = strings.TrimSpace(string(body))
+	if e.Status == 0 {
+		e.Status = resp.StatusCode
+	}
+	if e.Reason == "" {
+		e.Reason = http.StatusText(resp.StatusCode)
+	}
+	if e.Path == "" && resp.Request != nil {
+		e.Path = resp.Request.URL.Path
+	}
+	if e.Message == "" {
+		e.Message = e.Reason
+	}
+	return e
+}
+
+func (e *InitializrError) Error() string {
+	return fmt.Sprintf("spring initializr responded with %d %s: %s", e.Status, e.Reason, e.Message)
+}
+
+var (
+	incompatibleDependencyPattern = regexp.MustCompile(`(?i)dependency '([^']+)' is not (compatible|supported)`)
+	unknownDependencyPattern      = regexp.MustCompile(`(?i)unknown dependency '([^']+)'`)
+	javaVersionPattern            = regexp.MustCompile(`(?i)(java|jvm) ?version`)
+	bootVersionPattern            = regexp.MustCompile(`(?i)(spring boot|boot|platform) ?version`)
+)
+
+// Hint suggests how to fix the most common causes of the error, or returns
+// an empty string when there is nothing to suggest.
+func (e *InitializrError) Hint() string {
+	switch {
+	case incompatibleDependencyPattern.MatchString(e.Message):
+		dependency := incompatibleDependencyPattern.FindStringSubmatch(e.Message)[1]
+		return fmt.Sprintf("Remove %s from the dependencies or pick a Spring Boot version it supports.", dependency)
+	case unknownDependencyPattern.MatchString(e.Message):
+		dependency := unknownDependencyPattern.FindStringSubmatch(e.Message)[1]
+		return fmt.Sprintf("%s is not offered by this Spring Initializr, remove it from the dependencies.", dependency)
+	case javaVersionPattern.MatchString(e.Message):
+		return "Pick one of the versions listed in the Java section."
+	case bootVersionPattern.MatchString(e.Message):
+		return "Pick one of the versions listed in the Spring Boot section."
+	}
+	return ""
+}
+
+// Details lists everything the server said about the error.
+func (e *InitializrError) Details() string {
+	details := []string{
+		fmt.Sprintf("Status: %d %s", e.Status, e.Reason),
+		fmt.Sprintf("Path: %s", e.Path),
+		fmt.Sprintf("Message: %s", e.Message),
+	}
+	if e.Body != "" {
+		details = append(details, "", "Response:", e.Body)
+	}
+	return strings.Join(details, "\n")
+}
@@ -0,0 +1,60 @@
+package springio
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDownloadGeneratedInitializrError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status":400,"error":"Bad Request","message":"Dependency 'graphql' is not compatible with Spring Boot 2.7.0","path":"/starter.zip"}`))
+	}))
+	defer server.Close()
+
+	_, err := DownloadGenerated(server.URL + "/starter.zip")
+	var initializrErr *InitializrError
+	if !errors.As(err, &initializrErr) {
+		t.Fatalf("error %v is not an *InitializrError", err)
+	}
+	if initializrErr.Status != 400 || initializrErr.Path != "/starter.zip" {
+		t.Errorf("got %+v", initializrErr)
+	}
+	if hint := initializrErr.Hint(); !strings.HasPrefix(hint, "Remove graphql") {
+		t.Errorf("hint = %q", hint)
+	}
+}
+
+func TestParseInitializrErrorPlainBody(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "https://start.spring.io/starter.zip", nil)
+	resp := &http.Response{StatusCode: http.StatusServiceUnavailable, Request: req}
+	e := parseInitializrError(resp, []byte("upstream unavailable\n"))
+
+	if e.Status != 503 || e.Reason != "Service Unavailable" || e.Message != "Service Unavailable" {
+		t.Errorf("got %+v", e)
+	}
+	if e.Path != "/starter.zip" {
+		t.Errorf("path = %q", e.Path)
+	}
+	if !strings.HasSuffix(e.Details(), "Response:\nupstream unavailable") {
+		t.Errorf("details don't end with the raw body:\n%s", e.Details())
+	}
+}
+
+func TestHint(t *testing.T) {
+	tests := map[string]string{
+		"Unknown dependency 'foo'":               "foo is not offered by this Spring Initializr, remove it from the dependencies.",
+		"Invalid Java version '8'":               "Pick one of the versions listed in the Java section.",
+		"Invalid Spring Boot version '1.5.0'":    "Pick one of the versions listed in the Spring Boot section.",
+		"Something else went wrong on our side.": "",
+	}
+	for message, want := range tests {
+		if got := (&InitializrError{Message: message}).Hint(); got != want {
+			t.Errorf("Hint for %q = %q, want %q", message, got, want)
+		}
+	}
+}
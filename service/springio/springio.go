@@ -1,12 +1,17 @@
 package springio
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"os"
+	"path"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/eslam-allam/spring-initializer-go/constants"
@@ -27,13 +32,63 @@ const (
 )
 
 type metaField struct {
-	Id          string        `json:"id"`
-	Name        string        `json:"name"`
-	Description string        `json:"description"`
-	Type        metaFieldType `json:"type"`
-	Default     string        `json:"default"`
-	Action      string        `json:"action"`
-	Values      []metaField   `json:"values"`
+	Id           string              `json:"id"`
+	Name         string              `json:"name"`
+	Description  string              `json:"description"`
+	Type         metaFieldType       `json:"type"`
+	Default      string              `json:"default"`
+	Action       string              `json:"action"`
+	VersionRange string              `json:"versionRange"`
+	Links        map[string]LinkList `json:"_links"`
+	Values       []metaField         `json:"values"`
+}
+
+type Link struct {
+	Href      string `json:"href"`
+	Title     string `json:"title"`
+	Templated bool   `json:"templated"`
+}
+
+// LinkList holds the links of a relation, which the metadata encodes either
+// as a single object or as an array.
+type LinkList []Link
+
+func (l *LinkList) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		var links []Link
+		if err := json.Unmarshal(data, &links); err != nil {
+			return err
+		}
+		*l = links
+		return nil
+	}
+	var link Link
+	if err := json.Unmarshal(data, &link); err != nil {
+		return err
+	}
+	*l = LinkList{link}
+	return nil
+}
+
+// FormatVersionRange describes a Spring Boot compatibility range such as
+// "[3.0.0,3.3.0-M1)" in words.
+func FormatVersionRange(r string) string {
+	r = strings.TrimSpace(r)
+	if r == "" {
+		return "all versions"
+	}
+	lower, upper, isRange := strings.Cut(strings.Trim(r, "[]()"), ",")
+	if !isRange {
+		return fmt.Sprintf(">= %s", r)
+	}
+	lowerOp, upperOp := ">", "<"
+	if strings.HasPrefix(r, "[") {
+		lowerOp = ">="
+	}
+	if strings.HasSuffix(r, "]") {
+		upperOp = "<="
+	}
+	return fmt.Sprintf("%s %s and %s %s", lowerOp, strings.TrimSpace(lower), upperOp, strings.TrimSpace(upper))
 }
 
 type SpringInitMeta struct {
@@ -72,14 +127,33 @@ func GetMeta() (SpringInitMeta, error) {
 	return responseObject, nil
 }
 
-func DownloadGeneratedZip(url string, filepath string) error {
+func fetchGenerated(url string) (*http.Response, error) {
 	resp, err := client.Get(url)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("error downloading file: %s, %s", resp.Status, body)
+		return nil, parseInitializrError(resp, body)
+	}
+	return resp, nil
+}
+
+// DownloadGenerated fetches a generated project into memory.
+func DownloadGenerated(url string) ([]byte, error) {
+	resp, err := fetchGenerated(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+func DownloadGeneratedZip(url string, filepath string) error {
+	resp, err := fetchGenerated(url)
+	if err != nil {
+		return err
 	}
 	defer resp.Body.Close()
 	// Create the output file
@@ -125,3 +199,310 @@ func GenerateDownloadRequest(action, project, language, springBootVersion,
 
 	return url, nil
 }
+
+// ProjectConfig captures every choice needed to generate a project. Empty
+// fields fall back to whatever is currently selected or to the metadata
+// defaults.
+type ProjectConfig struct {
+	Type         string            `yaml:"type,omitempty"`
+	Language     string            `yaml:"language,omitempty"`
+	BootVersion  string            `yaml:"bootVersion,omitempty"`
+	Packaging    string            `yaml:"packaging,omitempty"`
+	JavaVersion  string            `yaml:"javaVersion,omitempty"`
+	Metadata     map[string]string `yaml:"metadata,omitempty"`
+	Dependencies []string          `yaml:"dependencies,omitempty"`
+}
+
+// MetadataValues returns the metadata of the config sorted by id.
+func (c ProjectConfig) MetadataValues() []metadata.FieldValue {
+	values := make([]metadata.FieldValue, 0, len(c.Metadata))
+	for id, value := range c.Metadata {
+		values = append(values, metadata.FieldValue{Id: id, Value: value})
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Id < values[j].Id
+	})
+	return values
+}
+
+// WithDefaults fills every unset field of c with the defaults advertised by
+// the metadata.
+func (meta SpringInitMeta) WithDefaults(c ProjectConfig) ProjectConfig {
+	fallback := func(value *string, field metaField) {
+		if *value == "" {
+			*value = field.Default
+		}
+	}
+	fallback(&c.Type, meta.Type)
+	fallback(&c.Language, meta.Language)
+	fallback(&c.BootVersion, meta.BootVersion)
+	fallback(&c.Packaging, meta.Packaging)
+	fallback(&c.JavaVersion, meta.JavaVersion)
+
+	values := make(map[string]string, len(c.Metadata))
+	for id, value := range c.Metadata {
+		values[id] = value
+	}
+	for id, field := range map[string]metaField{
+		"groupId":     meta.GroupId,
+		"artifactId":  meta.ArtifactId,
+		"name":        meta.Name,
+		"description": meta.Description,
+		"packageName": meta.PackageName,
+	} {
+		if values[id] == "" {
+			values[id] = field.Default
+		}
+	}
+	c.Metadata = values
+	return c
+}
+
+// DependencyIds returns the ids of every dependency in the metadata.
+func (meta SpringInitMeta) DependencyIds() []string {
+	ids := make([]string, 0)
+	for _, group := range meta.Dependencies.Values {
+		for _, d := range group.Values {
+			ids = append(ids, d.Id)
+		}
+	}
+	return ids
+}
+
+// Action returns the generation endpoint of the given project type.
+func (meta SpringInitMeta) Action(projectType string) (string, bool) {
+	for _, t := range meta.Type.Values {
+		if t.Id == projectType {
+			return t.Action, true
+		}
+	}
+	return "", false
+}
+
+// ArchiveName returns the name of the file saved by GenerateProject for the
+// given action.
+func ArchiveName(action string) string {
+	return path.Base(action)
+}
+
+// GenerateProject downloads the project described by c into targetDirectory
+// and reports whether the downloaded file is a zip archive.
+func GenerateProject(action string, c ProjectConfig, targetDirectory string) (fullPath string, isZip bool, err error) {
+	url, err := GenerateDownloadRequest(action, c.Type, c.Language, c.BootVersion,
+		c.Packaging, c.JavaVersion, c.Dependencies, c.MetadataValues())
+	if err != nil {
+		return fullPath, isZip, fmt.Errorf("error generating download request: %v", err)
+	}
+
+	baseName := ArchiveName(action)
+	fullPath = path.Join(targetDirectory, baseName)
+	err = DownloadGeneratedZip(url.String(), fullPath)
+	if err != nil {
+		// Wrapped so that callers can tell an *InitializrError apart.
+		return fullPath, isZip, fmt.Errorf("error downloading zip: %w", err)
+	}
+	return fullPath, strings.HasSuffix(baseName, "zip"), nil
+}
+
+// shareParams maps the parameter names used by start.spring.io share links to
+// the fields of ProjectConfig. Older links use bootVersion and javaVersion.
+var shareParams = map[string]func(c *ProjectConfig, value string){
+	"type":            func(c *ProjectConfig, value string) { c.Type = value },
+	"language":        func(c *ProjectConfig, value string) { c.Language = value },
+	"platformVersion": func(c *ProjectConfig, value string) { c.BootVersion = value },
+	"bootVersion":     func(c *ProjectConfig, value string) { c.BootVersion = value },
+	"packaging":       func(c *ProjectConfig, value string) { c.Packaging = value },
+	"jvmVersion":      func(c *ProjectConfig, value string) { c.JavaVersion = value },
+	"javaVersion":     func(c *ProjectConfig, value string) { c.JavaVersion = value },
+	"dependencies": func(c *ProjectConfig, value string) {
+		for _, d := range strings.Split(value, ",") {
+			if d = strings.TrimSpace(d); d != "" {
+				c.Dependencies = append(c.Dependencies, d)
+			}
+		}
+	},
+}
+
+// ParseShareUrl reads a start.spring.io share link such as
+// https://start.spring.io/#!type=maven-project&dependencies=web into a
+// ProjectConfig. Plain query parameters are accepted as well. Parameters
+// that aren't project options are kept as metadata.
+func ParseShareUrl(raw string) (ProjectConfig, error) {
+	var c ProjectConfig
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return c, fmt.Errorf("invalid url: %v", err)
+	}
+
+	// The escaped fragment keeps the values encoded so that they are only
+	// decoded once, by ParseQuery.
+	query := u.RawQuery
+	if fragment := u.EscapedFragment(); strings.HasPrefix(fragment, "!") {
+		query = strings.TrimPrefix(fragment, "!")
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return c, fmt.Errorf("invalid share parameters: %v", err)
+	}
+	if len(values) == 0 {
+		return c, errors.New("url does not contain any project options")
+	}
+
+	c.Metadata = make(map[string]string)
+	for name, vals := range values {
+		for _, value := range vals {
+			if apply, ok := shareParams[name]; ok {
+				apply(&c, value)
+				continue
+			}
+			c.Metadata[name] = value
+		}
+	}
+	return c, nil
+}
+
+// Override returns c with every field that is set in o replacing its own.
+func (c ProjectConfig) Override(o ProjectConfig) ProjectConfig {
+	override := func(value *string, other string) {
+		if other != "" {
+			*value = other
+		}
+	}
+	override(&c.Type, o.Type)
+	override(&c.Language, o.Language)
+	override(&c.BootVersion, o.BootVersion)
+	override(&c.Packaging, o.Packaging)
+	override(&c.JavaVersion, o.JavaVersion)
+
+	values := make(map[string]string, len(c.Metadata)+len(o.Metadata))
+	for id, value := range c.Metadata {
+		values[id] = value
+	}
+	for id, value := range o.Metadata {
+		values[id] = value
+	}
+	c.Metadata = values
+
+	if o.Dependencies != nil {
+		c.Dependencies = o.Dependencies
+	}
+	return c
+}
+
+// Unavailable returns a description of the values in c that the metadata
+// doesn't offer.
+func (meta SpringInitMeta) Unavailable(c ProjectConfig) []string {
+	unavailable := make([]string, 0)
+	offers := func(field metaField, value string) bool {
+		for _, v := range field.Values {
+			if v.Id == value || v.Name == value {
+				return true
+			}
+		}
+		return false
+	}
+	for _, s := range []struct {
+		field metaField
+		id    string
+		value string
+	}{
+		{meta.Type, "type", c.Type},
+		{meta.Language, "language", c.Language},
+		{meta.BootVersion, "bootVersion", c.BootVersion},
+		{meta.Packaging, "packaging", c.Packaging},
+		{meta.JavaVersion, "javaVersion", c.JavaVersion},
+	} {
+		if s.value != "" && !offers(s.field, s.value) {
+			unavailable = append(unavailable, fmt.Sprintf("%s=%s", s.id, s.value))
+		}
+	}
+
+	for _, d := range c.Dependencies {
+		found := false
+		for _, group := range meta.Dependencies.Values {
+			if offers(group, d) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unavailable = append(unavailable, fmt.Sprintf("dependency=%s", d))
+		}
+	}
+	return unavailable
+}
+
+// shareValues returns the parameters of c in the order start.spring.io uses
+// for its share links, with dependencies joined by commas.
+func (c ProjectConfig) shareValues(bootVersionParam, javaVersionParam string) [][2]string {
+	values := [][2]string{
+		{"type", c.Type},
+		{"language", c.Language},
+		{bootVersionParam, c.BootVersion},
+		{"packaging", c.Packaging},
+		{javaVersionParam, c.JavaVersion},
+	}
+	for _, m := range c.MetadataValues() {
+		values = append(values, [2]string{m.Id, m.Value})
+	}
+	if len(c.Dependencies) > 0 {
+		values = append(values, [2]string{"dependencies", strings.Join(c.Dependencies, ",")})
+	}
+
+	set := make([][2]string, 0, len(values))
+	for _, v := range values {
+		if v[1] != "" {
+			set = append(set, v)
+		}
+	}
+	return set
+}
+
+// ShareUrl builds the start.spring.io link that opens the web UI with the
+// choices of c.
+func ShareUrl(c ProjectConfig) string {
+	params := make([]string, 0)
+	for _, v := range c.shareValues("platformVersion", "jvmVersion") {
+		value := strings.NewReplacer("+", "%20", "%2C", ",").Replace(url.QueryEscape(v[1]))
+		params = append(params, fmt.Sprintf("%s=%s", v[0], value))
+	}
+	return fmt.Sprintf("%s/#!%s", constants.SpringUrl, strings.Join(params, "&"))
+}
+
+// CurlCommand builds a curl invocation downloading the project of c.
+func CurlCommand(action string, c ProjectConfig) (string, error) {
+	endpoint, err := url.JoinPath(constants.SpringUrl, action)
+	if err != nil {
+		return "", err
+	}
+	args := []string{"curl", "-G", endpoint}
+	for _, v := range c.shareValues("bootVersion", "javaVersion") {
+		args = append(args, "--data-urlencode", shellQuote(fmt.Sprintf("%s=%s", v[0], v[1])))
+	}
+	args = append(args, "-o", shellQuote(path.Base(action)))
+	return strings.Join(args, " "), nil
+}
+
+// HttpieCommand builds an HTTPie invocation downloading the project of c.
+func HttpieCommand(action string, c ProjectConfig) (string, error) {
+	endpoint, err := url.JoinPath(constants.SpringUrl, action)
+	if err != nil {
+		return "", err
+	}
+	args := []string{"http", "-d", endpoint}
+	for _, v := range c.shareValues("bootVersion", "javaVersion") {
+		args = append(args, shellQuote(fmt.Sprintf("%s==%s", v[0], v[1])))
+	}
+	args = append(args, "-o", shellQuote(path.Base(action)))
+	return strings.Join(args, " "), nil
+}
+
+func shellQuote(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' ||
+			strings.ContainsRune("-_.,/:=+@", r))
+	}) == -1 {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,129 @@
+package springio
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseShareUrlDecodesOnce(t *testing.T) {
+	c, err := ParseShareUrl("https://start.spring.io/#!description=C%2B%2B%20100%25&name=a")
+	if err != nil {
+		t.Fatalf("ParseShareUrl: %v", err)
+	}
+	if got := c.Metadata["description"]; got != "C++ 100%" {
+		t.Errorf("description = %q, want %q", got, "C++ 100%")
+	}
+	if got := c.Metadata["name"]; got != "a" {
+		t.Errorf("name = %q, want %q", got, "a")
+	}
+}
+
+func TestParseShareUrl(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want ProjectConfig
+	}{
+		{
+			name: "fragment",
+			raw:  "https://start.spring.io/#!type=maven-project&platformVersion=3.2.0&jvmVersion=21&dependencies=web,data-jpa",
+			want: ProjectConfig{
+				Type:         "maven-project",
+				BootVersion:  "3.2.0",
+				JavaVersion:  "21",
+				Dependencies: []string{"web", "data-jpa"},
+				Metadata:     map[string]string{},
+			},
+		},
+		{
+			name: "query with old names",
+			raw:  "https://start.spring.io/starter.zip?bootVersion=3.1.5&javaVersion=17&groupId=com.acme",
+			want: ProjectConfig{
+				BootVersion: "3.1.5",
+				JavaVersion: "17",
+				Metadata:    map[string]string{"groupId": "com.acme"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseShareUrl(tt.raw)
+			if err != nil {
+				t.Fatalf("ParseShareUrl: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseShareUrlWithoutOptions(t *testing.T) {
+	if _, err := ParseShareUrl("https://start.spring.io/"); err == nil {
+		t.Error("expected an error for a link without project options")
+	}
+}
+
+func TestShareUrlRoundTrip(t *testing.T) {
+	c := ProjectConfig{
+		Type:         "gradle-project",
+		Language:     "kotlin",
+		BootVersion:  "3.2.0",
+		Packaging:    "jar",
+		JavaVersion:  "21",
+		Dependencies: []string{"web", "security"},
+		Metadata: map[string]string{
+			"groupId":     "com.example",
+			"artifactId":  "demo",
+			"description": "C++ & Go: 100% + more, really",
+		},
+	}
+	got, err := ParseShareUrl(ShareUrl(c))
+	if err != nil {
+		t.Fatalf("ParseShareUrl: %v", err)
+	}
+	if !reflect.DeepEqual(got, c) {
+		t.Errorf("round trip gave %+v, want %+v", got, c)
+	}
+}
+
+func TestCurlCommand(t *testing.T) {
+	c := ProjectConfig{
+		Type:         "maven-project",
+		BootVersion:  "3.2.0",
+		Dependencies: []string{"web", "data-jpa"},
+		Metadata: map[string]string{
+			"artifactId":  "demo",
+			"description": "Demo project for Spring Boot & more",
+		},
+	}
+	got, err := CurlCommand("/starter.zip", c)
+	if err != nil {
+		t.Fatalf("CurlCommand: %v", err)
+	}
+	want := "curl -G https://start.spring.io/starter.zip" +
+		" --data-urlencode type=maven-project" +
+		" --data-urlencode bootVersion=3.2.0" +
+		" --data-urlencode artifactId=demo" +
+		" --data-urlencode 'description=Demo project for Spring Boot & more'" +
+		" --data-urlencode dependencies=web,data-jpa" +
+		" -o starter.zip"
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestHttpieCommand(t *testing.T) {
+	c := ProjectConfig{
+		Language: "java",
+		Metadata: map[string]string{"name": "it's"},
+	}
+	got, err := HttpieCommand("/starter.zip", c)
+	if err != nil {
+		t.Fatalf("HttpieCommand: %v", err)
+	}
+	want := `http -d https://start.spring.io/starter.zip language==java 'name==it'\''s' -o starter.zip`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
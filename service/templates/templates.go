@@ -0,0 +1,254 @@
+// Package templates adds the files of template directories to generated
+// projects. The paths of the files, and the contents of the ones ending in
+// .tmpl, are rendered with text/template using the values of the project,
+// such as {{.artifactId}} or {{.packagePath}}, and the .tmpl extension is
+// dropped. Any other file is copied as it is. A .tmpl file keeps a literal
+// {{ by printing it as a raw string: {{`{{`}}.
+package templates
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/eslam-allam/spring-initializer-go/service/config"
+	"github.com/eslam-allam/spring-initializer-go/service/files"
+	"github.com/eslam-allam/spring-initializer-go/service/springio"
+)
+
+const (
+	dirName       = "templates"
+	templateExt   = ".tmpl"
+	backupExt     = ".orig"
+	pathSeparator = "/"
+)
+
+// Conflict tells what to do with a template whose file was already
+// generated.
+type Conflict string
+
+const (
+	// SKIP keeps the generated file.
+	SKIP Conflict = "skip"
+	// OVERWRITE replaces the generated file.
+	OVERWRITE Conflict = "overwrite"
+	// BACKUP replaces the generated file, keeping it with a .orig extension.
+	BACKUP Conflict = "backup"
+)
+
+// ParseConflict reads the conflict handling set in the settings, skipping
+// conflicts by default.
+func ParseConflict(s string) (Conflict, error) {
+	switch c := Conflict(strings.ToLower(strings.TrimSpace(s))); c {
+	case "":
+		return SKIP, nil
+	case SKIP, OVERWRITE, BACKUP:
+		return c, nil
+	default:
+		return SKIP, fmt.Errorf("unknown template conflict handling %q, expected skip, overwrite or backup", s)
+	}
+}
+
+// Dirs returns the template directories applied to a project: the global
+// one, that is the one configured or else the templates folder of the config
+// directory when it exists, followed by the one of the profile if any.
+func Dirs(configured, profileDir string) ([]string, error) {
+	dirs := make([]string, 0, 2)
+	if configured == "" {
+		base, err := config.Dir()
+		if err != nil {
+			return nil, err
+		}
+		if info, err := os.Stat(filepath.Join(base, dirName)); err == nil && info.IsDir() {
+			dirs = append(dirs, filepath.Join(base, dirName))
+		}
+	}
+	for _, dir := range []string{configured, profileDir} {
+		if dir == "" {
+			continue
+		}
+		dir, err := files.ExpandPath(dir)
+		if err != nil {
+			return nil, err
+		}
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			return nil, fmt.Errorf("template directory %s not found", dir)
+		}
+		dirs = append(dirs, dir)
+	}
+	return dirs, nil
+}
+
+// Data returns the values available to the templates: the metadata of c
+// under their ids along with type, language, bootVersion, packaging,
+// javaVersion, dependencies and packagePath.
+func Data(c springio.ProjectConfig) map[string]any {
+	data := make(map[string]any, len(c.Metadata)+7)
+	for id, value := range c.Metadata {
+		data[id] = value
+	}
+	data["type"] = c.Type
+	data["language"] = c.Language
+	data["bootVersion"] = c.BootVersion
+	data["packaging"] = c.Packaging
+	data["javaVersion"] = c.JavaVersion
+	data["dependencies"] = c.Dependencies
+	data["packagePath"] = strings.ReplaceAll(c.Metadata["packageName"], ".", pathSeparator)
+	return data
+}
+
+// Result lists the files added to a project by their path within it.
+type Result struct {
+	Written  []string
+	Skipped  []string
+	BackedUp []string
+}
+
+func (r Result) String() string {
+	summary := fmt.Sprintf("Added %d template files.", len(r.Written))
+	if len(r.BackedUp) > 0 {
+		summary += fmt.Sprintf(" Kept the generated %s with a %s extension.", strings.Join(r.BackedUp, ", "), backupExt)
+	}
+	if len(r.Skipped) > 0 {
+		summary += fmt.Sprintf(" Skipped %s, already generated.", strings.Join(r.Skipped, ", "))
+	}
+	return summary
+}
+
+// sources lists the files of every template directory by their path within
+// it. Files of the later directories take over those of the earlier ones.
+func sources(dirs []string) (map[string]string, error) {
+	found := make(map[string]string)
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(dir, p)
+			if err != nil {
+				return err
+			}
+			found[filepath.ToSlash(rel)] = p
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return found, nil
+}
+
+func render(name, text string, data map[string]any) ([]byte, error) {
+	t, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	if err := t.Execute(&out, data); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// file is a template file ready to be written into the project.
+type file struct {
+	rel      string
+	contents []byte
+	mode     fs.FileMode
+	exists   bool
+}
+
+// prepare renders the template file at src, named name within its template
+// directory, for projectDir.
+func prepare(projectDir, name, src string, data map[string]any) (file, error) {
+	var f file
+	target, err := render(name, strings.TrimSuffix(name, templateExt), data)
+	if err != nil {
+		return f, fmt.Errorf("failed to render the path of %s: %v", name, err)
+	}
+	f.rel = string(target)
+
+	info, err := os.Stat(src)
+	if err != nil {
+		return f, err
+	}
+	f.mode = info.Mode().Perm()
+	if f.contents, err = os.ReadFile(src); err != nil {
+		return f, err
+	}
+	if strings.HasSuffix(name, templateExt) {
+		if f.contents, err = render(name, string(f.contents), data); err != nil {
+			return f, fmt.Errorf("failed to render %s: %v", name, err)
+		}
+	}
+
+	_, err = os.Stat(filepath.Join(projectDir, filepath.FromSlash(f.rel)))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return f, err
+	}
+	f.exists = err == nil
+	return f, nil
+}
+
+// Apply renders the files of the template directories into projectDir,
+// handling the files that were already generated as told by conflict. Every
+// file is rendered before any is written, so that a template failing to
+// render leaves the project untouched.
+func Apply(projectDir string, c springio.ProjectConfig, conflict Conflict, dirs ...string) (Result, error) {
+	var result Result
+	found, err := sources(dirs)
+	if err != nil {
+		return result, err
+	}
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data := Data(c)
+	prepared := make([]file, 0, len(names))
+	for _, name := range names {
+		f, err := prepare(projectDir, name, found[name], data)
+		if err != nil {
+			return result, err
+		}
+		prepared = append(prepared, f)
+	}
+
+	for _, f := range prepared {
+		fullPath := filepath.Join(projectDir, filepath.FromSlash(f.rel))
+		if f.exists {
+			switch conflict {
+			case OVERWRITE:
+			case BACKUP:
+				if err := os.Rename(fullPath, fullPath+backupExt); err != nil {
+					return result, err
+				}
+				result.BackedUp = append(result.BackedUp, f.rel)
+			default:
+				result.Skipped = append(result.Skipped, f.rel)
+				continue
+			}
+		}
+
+		if err := os.MkdirAll(filepath.Dir(fullPath), os.ModePerm); err != nil {
+			return result, err
+		}
+		if err := os.WriteFile(fullPath, f.contents, f.mode); err != nil {
+			return result, err
+		}
+		// WriteFile leaves the mode of an overwritten file alone.
+		if err := os.Chmod(fullPath, f.mode); err != nil {
+			return result, err
+		}
+		result.Written = append(result.Written, f.rel)
+	}
+	return result, nil
+}
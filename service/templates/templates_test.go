@@ -0,0 +1,224 @@
+package templates
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/eslam-allam/spring-initializer-go/service/springio"
+)
+
+var project = springio.ProjectConfig{
+	BootVersion:  "3.2.0",
+	JavaVersion:  "21",
+	Dependencies: []string{"web", "data-jpa"},
+	Metadata: map[string]string{
+		"groupId":     "com.example",
+		"artifactId":  "demo",
+		"packageName": "com.example.demo",
+	},
+}
+
+// writeFiles creates the given files, keyed by their slash separated path,
+// inside dir.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func readFile(t *testing.T, dir, name string) string {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(content)
+}
+
+func TestParseConflict(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Conflict
+	}{
+		{"", SKIP},
+		{"skip", SKIP},
+		{" Overwrite ", OVERWRITE},
+		{"BACKUP", BACKUP},
+	}
+	for _, tt := range tests {
+		got, err := ParseConflict(tt.in)
+		if err != nil {
+			t.Errorf("ParseConflict(%q): %v", tt.in, err)
+		}
+		if got != tt.want {
+			t.Errorf("ParseConflict(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+	if _, err := ParseConflict("merge"); err == nil {
+		t.Error("expected an error for an unknown conflict handling")
+	}
+}
+
+func TestDirs(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	t.Setenv("HOME", configHome)
+	base, err := os.UserConfigDir()
+	if err != nil {
+		t.Skip("no config directory")
+	}
+	global := filepath.Join(base, "spring-initializer", dirName)
+	profileDir := t.TempDir()
+
+	dirs, err := Dirs("", profileDir)
+	if err != nil {
+		t.Fatalf("Dirs: %v", err)
+	}
+	if !reflect.DeepEqual(dirs, []string{profileDir}) {
+		t.Errorf("without a templates folder got %v", dirs)
+	}
+
+	if err := os.MkdirAll(global, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	dirs, err = Dirs("", profileDir)
+	if err != nil {
+		t.Fatalf("Dirs: %v", err)
+	}
+	if !reflect.DeepEqual(dirs, []string{global, profileDir}) {
+		t.Errorf("got %v, want the templates folder then the profile's", dirs)
+	}
+
+	if _, err := Dirs(filepath.Join(configHome, "missing"), ""); err == nil {
+		t.Error("expected an error for a missing configured directory")
+	}
+}
+
+func TestApply(t *testing.T) {
+	global, profileDir, projectDir := t.TempDir(), t.TempDir(), t.TempDir()
+	writeFiles(t, global, map[string]string{
+		"src/main/java/{{.packagePath}}/Health.java.tmpl": "package {{.packageName}};\n// {{range .dependencies}}{{.}} {{end}}",
+		".github/workflows/ci.yml":                        "token: ${{ secrets.TOKEN }}",
+		"README.md.tmpl":                                  "global {{.artifactId}}",
+	})
+	writeFiles(t, profileDir, map[string]string{
+		"README.md.tmpl": "# {{.artifactId}} on {{.bootVersion}}",
+	})
+	binary := []byte{0x00, '{', '{', 0xff, '}', '}'}
+	if err := os.WriteFile(filepath.Join(global, "logo.png"), binary, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(global, "run.sh"), []byte("#!/bin/sh"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Apply(projectDir, project, SKIP, global, profileDir)
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	want := []string{
+		".github/workflows/ci.yml",
+		"README.md",
+		"logo.png",
+		"run.sh",
+		"src/main/java/com/example/demo/Health.java",
+	}
+	if !reflect.DeepEqual(result.Written, want) {
+		t.Errorf("written %v, want %v", result.Written, want)
+	}
+
+	if got := readFile(t, projectDir, "src/main/java/com/example/demo/Health.java"); got != "package com.example.demo;\n// web data-jpa " {
+		t.Errorf("Health.java = %q", got)
+	}
+	if got := readFile(t, projectDir, "README.md"); got != "# demo on 3.2.0" {
+		t.Errorf("README.md = %q, want the profile's template", got)
+	}
+	if got := readFile(t, projectDir, ".github/workflows/ci.yml"); got != "token: ${{ secrets.TOKEN }}" {
+		t.Errorf("ci.yml = %q, want it copied as it is", got)
+	}
+	if got := readFile(t, projectDir, "logo.png"); !bytes.Equal([]byte(got), binary) {
+		t.Errorf("logo.png = %v, want %v", []byte(got), binary)
+	}
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(filepath.Join(projectDir, "run.sh"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != 0o755 {
+			t.Errorf("run.sh mode = %v, want 0755", info.Mode().Perm())
+		}
+	}
+}
+
+func TestApplyConflicts(t *testing.T) {
+	templatesDir := t.TempDir()
+	writeFiles(t, templatesDir, map[string]string{"HELP.md.tmpl": "help for {{.artifactId}}"})
+
+	tests := []struct {
+		conflict Conflict
+		want     string
+		backup   bool
+		result   Result
+	}{
+		{SKIP, "generated", false, Result{Skipped: []string{"HELP.md"}}},
+		{OVERWRITE, "help for demo", false, Result{Written: []string{"HELP.md"}}},
+		{BACKUP, "help for demo", true, Result{Written: []string{"HELP.md"}, BackedUp: []string{"HELP.md"}}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.conflict), func(t *testing.T) {
+			projectDir := t.TempDir()
+			writeFiles(t, projectDir, map[string]string{"HELP.md": "generated"})
+
+			result, err := Apply(projectDir, project, tt.conflict, templatesDir)
+			if err != nil {
+				t.Fatalf("Apply: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.result) {
+				t.Errorf("result %+v, want %+v", result, tt.result)
+			}
+			if got := readFile(t, projectDir, "HELP.md"); got != tt.want {
+				t.Errorf("HELP.md = %q, want %q", got, tt.want)
+			}
+			_, err = os.Stat(filepath.Join(projectDir, "HELP.md"+backupExt))
+			if backedUp := err == nil; backedUp != tt.backup {
+				t.Errorf("backup exists = %v, want %v", backedUp, tt.backup)
+			}
+		})
+	}
+}
+
+func TestApplyFailureLeavesProjectUntouched(t *testing.T) {
+	templatesDir, projectDir := t.TempDir(), t.TempDir()
+	writeFiles(t, templatesDir, map[string]string{
+		"HELP.md.tmpl": "help for {{.artifactId}}",
+		"a.txt":        "copied",
+		"z.txt.tmpl":   "{{.unknown}}",
+	})
+	writeFiles(t, projectDir, map[string]string{"HELP.md": "generated"})
+
+	if _, err := Apply(projectDir, project, BACKUP, templatesDir); err == nil {
+		t.Fatal("expected an error for a missing value")
+	}
+	entries, err := os.ReadDir(projectDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || readFile(t, projectDir, "HELP.md") != "generated" {
+		names := make([]string, len(entries))
+		for i, e := range entries {
+			names[i] = e.Name()
+		}
+		t.Errorf("project holds %v after a failure, want only the generated HELP.md", names)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"os"
 	"strings"
 
-	"github.com/eslam-allam/spring-initializer-go/constants"
 	"github.com/muesli/termenv"
 )
 
@@ -24,8 +23,8 @@ func ApplyColors(foreground, background string) termUpdate {
 	colorSet := false
 	term := os.Getenv("TERM")
 	if !strings.HasPrefix(term, "screen") && !strings.HasPrefix(term, "tmux") && !strings.HasPrefix(term, "dumb") {
-		output.SetBackgroundColor(termenv.RGBColor(constants.BackgroundColour))
-		output.SetForegroundColor(termenv.RGBColor(constants.ForegroundColour))
+		output.SetBackgroundColor(termenv.RGBColor(background))
+		output.SetForegroundColor(termenv.RGBColor(foreground))
 		colorSet = true
 	}
 	if !colorSet {
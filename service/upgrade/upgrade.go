@@ -0,0 +1,198 @@
+package upgrade
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/eslam-allam/spring-initializer-go/service/diff"
+	"github.com/eslam-allam/spring-initializer-go/service/importer"
+	"github.com/eslam-allam/spring-initializer-go/service/springio"
+)
+
+const contextLines = 3
+
+// comparedFiles are the build and wrapper files compared against a freshly
+// generated project.
+var comparedFiles = []string{
+	"pom.xml",
+	"build.gradle",
+	"build.gradle.kts",
+	"settings.gradle",
+	"settings.gradle.kts",
+	"mvnw",
+	"mvnw.cmd",
+	".mvn/wrapper/maven-wrapper.properties",
+	"gradlew",
+	"gradlew.bat",
+	"gradle/wrapper/gradle-wrapper.properties",
+}
+
+type BootVersion struct {
+	Id   string
+	Name string
+}
+
+// Upgrade compares an existing project with what Spring Initializr would
+// generate for it today.
+type Upgrade struct {
+	meta       springio.SpringInitMeta
+	projectDir string
+	config     springio.ProjectConfig
+	action     string
+	Unmapped   []string
+}
+
+// New reads the build file of the project in projectDir and resolves the
+// settings used to regenerate it.
+func New(projectDir string) (Upgrade, error) {
+	meta, err := springio.GetMeta()
+	if err != nil {
+		return Upgrade{}, fmt.Errorf("failed to load metadata: %v", err)
+	}
+	result, err := importer.Import(projectDir, meta.DependencyIds())
+	if err != nil {
+		return Upgrade{}, err
+	}
+	config := meta.WithDefaults(result.Config)
+	action, ok := meta.Action(config.Type)
+	if !ok {
+		return Upgrade{}, fmt.Errorf("unknown project type: %s", config.Type)
+	}
+	return Upgrade{
+		meta:       meta,
+		projectDir: filepath.Dir(result.BuildFile),
+		config:     config,
+		action:     action,
+		Unmapped:   result.Unmapped,
+	}, nil
+}
+
+// CurrentBootVersion returns the boot version the project is built with.
+func (u Upgrade) CurrentBootVersion() string {
+	return u.config.BootVersion
+}
+
+// DefaultBootVersion returns the boot version Spring Initializr recommends.
+func (u Upgrade) DefaultBootVersion() string {
+	return u.meta.BootVersion.Default
+}
+
+// ResolveBootVersion checks that version, given by its id or its name, is
+// offered by the metadata and returns its id.
+func (u Upgrade) ResolveBootVersion(version string) (string, error) {
+	versions := u.BootVersions()
+	allowed := make([]string, len(versions))
+	for i, v := range versions {
+		if v.Id == version || v.Name == version {
+			return v.Id, nil
+		}
+		allowed[i] = v.Id
+	}
+	return "", fmt.Errorf("boot version %q is not available (available: %s)", version, strings.Join(allowed, ", "))
+}
+
+func (u Upgrade) BootVersions() []BootVersion {
+	versions := make([]BootVersion, len(u.meta.BootVersion.Values))
+	for i, v := range u.meta.BootVersion.Values {
+		versions[i] = BootVersion{Id: v.Id, Name: v.Name}
+	}
+	return versions
+}
+
+// Diff regenerates the project in memory with the given boot version and
+// returns the unified diff of its build and wrapper files against the ones
+// on disk.
+func (u Upgrade) Diff(bootVersion string) (string, error) {
+	config := u.config
+	config.BootVersion = bootVersion
+	url, err := springio.GenerateDownloadRequest(u.action, config.Type, config.Language, config.BootVersion,
+		config.Packaging, config.JavaVersion, config.Dependencies, config.MetadataValues())
+	if err != nil {
+		return "", fmt.Errorf("error generating download request: %v", err)
+	}
+	data, err := springio.DownloadGenerated(url.String())
+	if err != nil {
+		return "", err
+	}
+	generated, err := readArchive(data)
+	if err != nil {
+		return "", err
+	}
+
+	s := strings.Builder{}
+	for _, name := range comparedFiles {
+		fresh, inArchive := generated[name]
+		existing, err := os.ReadFile(filepath.Join(u.projectDir, filepath.FromSlash(name)))
+		if errors.Is(err, os.ErrNotExist) {
+			if !inArchive {
+				continue
+			}
+		} else if err != nil {
+			return "", err
+		}
+		s.WriteString(diff.Unified("a/"+name, "b/"+name, string(existing), fresh, contextLines))
+	}
+	return s.String(), nil
+}
+
+// readArchive returns the text files of a generated zip keyed by their path
+// relative to the project root.
+func readArchive(data []byte) (map[string]string, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read generated archive: %v", err)
+	}
+
+	names := make([]string, 0, len(r.File))
+	for _, f := range r.File {
+		if !f.FileInfo().IsDir() {
+			names = append(names, f.Name)
+		}
+	}
+	sort.Strings(names)
+	prefix := commonRoot(names)
+
+	contents := make(map[string]string)
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		contents[strings.TrimPrefix(f.Name, prefix)] = string(content)
+	}
+	return contents, nil
+}
+
+// commonRoot returns the top level folder shared by every entry, as
+// Initializr nests projects under their artifact id.
+func commonRoot(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	root, _, found := strings.Cut(names[0], "/")
+	if !found {
+		return ""
+	}
+	for _, name := range names {
+		if !strings.HasPrefix(name, root+"/") {
+			return ""
+		}
+	}
+	return path.Clean(root) + "/"
+}
@@ -0,0 +1,96 @@
+package upgrade
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/eslam-allam/spring-initializer-go/service/springio"
+)
+
+func testUpgrade(t *testing.T) Upgrade {
+	t.Helper()
+	var meta springio.SpringInitMeta
+	err := json.Unmarshal([]byte(`{"bootVersion": {"default": "3.2.0", "values": [
+		{"id": "3.3.0-SNAPSHOT", "name": "3.3.0 (SNAPSHOT)"},
+		{"id": "3.2.0", "name": "3.2.0"},
+		{"id": "3.1.6", "name": "3.1.6"}
+	]}}`), &meta)
+	if err != nil {
+		t.Fatalf("unmarshal metadata: %v", err)
+	}
+	return Upgrade{meta: meta}
+}
+
+func TestResolveBootVersion(t *testing.T) {
+	u := testUpgrade(t)
+	for _, version := range []string{"3.3.0-SNAPSHOT", "3.3.0 (SNAPSHOT)"} {
+		got, err := u.ResolveBootVersion(version)
+		if err != nil {
+			t.Fatalf("ResolveBootVersion(%q): %v", version, err)
+		}
+		if got != "3.3.0-SNAPSHOT" {
+			t.Errorf("ResolveBootVersion(%q) = %q, want the id", version, got)
+		}
+	}
+}
+
+func TestResolveBootVersionUnknown(t *testing.T) {
+	_, err := testUpgrade(t).ResolveBootVersion("3.2")
+	if err == nil {
+		t.Fatal("expected an error for an unknown boot version")
+	}
+	if !strings.Contains(err.Error(), "3.3.0-SNAPSHOT, 3.2.0, 3.1.6") {
+		t.Errorf("error %q doesn't list the available versions", err)
+	}
+}
+
+func TestCommonRoot(t *testing.T) {
+	tests := []struct {
+		names []string
+		want  string
+	}{
+		{nil, ""},
+		{[]string{"demo/pom.xml", "demo/mvnw"}, "demo/"},
+		{[]string{"demo/pom.xml", "other/mvnw"}, ""},
+		{[]string{"pom.xml"}, ""},
+	}
+	for _, tt := range tests {
+		if got := commonRoot(tt.names); got != tt.want {
+			t.Errorf("commonRoot(%v) = %q, want %q", tt.names, got, tt.want)
+		}
+	}
+}
+
+func TestReadArchive(t *testing.T) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"demo/pom.xml": "<project/>",
+		"demo/.mvn/wrapper/maven-wrapper.properties": "distributionUrl=x",
+	} {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Write([]byte(content))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readArchive(buf.Bytes())
+	if err != nil {
+		t.Fatalf("readArchive: %v", err)
+	}
+	want := map[string]string{
+		"pom.xml":                               "<project/>",
+		".mvn/wrapper/maven-wrapper.properties": "distributionUrl=x",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}